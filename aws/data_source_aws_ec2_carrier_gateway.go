@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsEc2CarrierGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2CarrierGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2CarrierGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeCarrierGatewaysInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.CarrierGatewayIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = buildEC2AttributeFilterList(map[string]string{
+		"vpc-id": d.Get("vpc_id").(string),
+	})
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filters = append(input.Filters, buildEC2TagFilterList(
+			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
+		)...)
+	}
+
+	if filters, ok := d.GetOk("filter"); ok {
+		input.Filters = append(input.Filters, buildEC2CustomFilterList(
+			filters.(*schema.Set),
+		)...)
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Carrier Gateway: %s", input)
+	var carrierGateways []*ec2.CarrierGateway
+
+	err := conn.DescribeCarrierGatewaysPages(input, func(page *ec2.DescribeCarrierGatewaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, carrierGateway := range page.CarrierGateways {
+			if carrierGateway == nil || aws.StringValue(carrierGateway.State) == ec2.CarrierGatewayStateDeleted {
+				continue
+			}
+
+			carrierGateways = append(carrierGateways, carrierGateway)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Carrier Gateways: %w", err)
+	}
+
+	if len(carrierGateways) == 0 {
+		return fmt.Errorf("no matching EC2 Carrier Gateway found")
+	}
+
+	if len(carrierGateways) > 1 {
+		return fmt.Errorf("multiple EC2 Carrier Gateways matched; use additional constraints to reduce matches to a single EC2 Carrier Gateway")
+	}
+
+	carrierGateway := carrierGateways[0]
+
+	d.SetId(aws.StringValue(carrierGateway.CarrierGatewayId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: aws.StringValue(carrierGateway.OwnerId),
+		Resource:  fmt.Sprintf("carrier-gateway/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("owner_id", carrierGateway.OwnerId)
+	d.Set("vpc_id", carrierGateway.VpcId)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(carrierGateway.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsEc2CarrierGateway_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ec2_carrier_gateway.test"
+	ds1ResourceName := "data.aws_ec2_carrier_gateway.by_id"
+	ds2ResourceName := "data.aws_ec2_carrier_gateway.by_vpc_id"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSWavelengthZoneAvailable(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2CarrierGatewayConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "vpc_id", resourceName, "vpc_id"),
+
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsEc2CarrierGateway_noMatch(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSWavelengthZoneAvailable(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsEc2CarrierGatewayConfigNoMatch(rName),
+				ExpectError: regexp.MustCompile(`no matching EC2 Carrier Gateway found`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEc2CarrierGatewayConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_carrier_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_carrier_gateway" "by_id" {
+  id = aws_ec2_carrier_gateway.test.id
+}
+
+data "aws_ec2_carrier_gateway" "by_vpc_id" {
+  vpc_id = aws_ec2_carrier_gateway.test.vpc_id
+}
+`, rName)
+}
+
+func testAccDataSourceAwsEc2CarrierGatewayConfigNoMatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_carrier_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
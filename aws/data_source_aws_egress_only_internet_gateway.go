@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsEgressOnlyInternetGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEgressOnlyInternetGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"egress_only_internet_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEgressOnlyInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{}
+
+	if v, ok := d.GetOk("egress_only_internet_gateway_id"); ok {
+		input.EgressOnlyInternetGatewayIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filters = append(input.Filters, buildEC2TagFilterList(
+			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
+		)...)
+	}
+
+	if filters, ok := d.GetOk("filter"); ok {
+		input.Filters = append(input.Filters, buildEC2CustomFilterList(
+			filters.(*schema.Set),
+		)...)
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Egress Only Internet Gateway: %s", input)
+	var egressOnlyInternetGateways []*ec2.EgressOnlyInternetGateway
+	vpcID := d.Get("vpc_id").(string)
+
+	err := conn.DescribeEgressOnlyInternetGatewaysPages(input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, egressOnlyInternetGateway := range page.EgressOnlyInternetGateways {
+			if egressOnlyInternetGateway == nil {
+				continue
+			}
+
+			// DescribeEgressOnlyInternetGateways has no filter on the attached VPC.
+			if vpcID != "" && egressOnlyInternetGatewayAttachedVpcID(egressOnlyInternetGateway) != vpcID {
+				continue
+			}
+
+			egressOnlyInternetGateways = append(egressOnlyInternetGateways, egressOnlyInternetGateway)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Egress Only Internet Gateways: %w", err)
+	}
+
+	if len(egressOnlyInternetGateways) == 0 {
+		return fmt.Errorf("no matching EC2 Egress Only Internet Gateway found")
+	}
+
+	if len(egressOnlyInternetGateways) > 1 {
+		return fmt.Errorf("multiple EC2 Egress Only Internet Gateways matched; use additional constraints to reduce matches to a single EC2 Egress Only Internet Gateway")
+	}
+
+	egressOnlyInternetGateway := egressOnlyInternetGateways[0]
+
+	d.SetId(aws.StringValue(egressOnlyInternetGateway.EgressOnlyInternetGatewayId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("egress-only-internet-gateway/%s", d.Id()),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("egress_only_internet_gateway_id", egressOnlyInternetGateway.EgressOnlyInternetGatewayId)
+
+	d.Set("vpc_id", egressOnlyInternetGatewayAttachedVpcID(egressOnlyInternetGateway))
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(egressOnlyInternetGateway.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+// egressOnlyInternetGatewayAttachedVpcID returns the ID of the VPC the egress-only internet gateway is attached to.
+// Attachments that are not in the attached state, such as one being detached, are ignored.
+func egressOnlyInternetGatewayAttachedVpcID(egressOnlyInternetGateway *ec2.EgressOnlyInternetGateway) string {
+	for _, attachment := range egressOnlyInternetGateway.Attachments {
+		if attachment != nil && aws.StringValue(attachment.State) == ec2.AttachmentStatusAttached {
+			return aws.StringValue(attachment.VpcId)
+		}
+	}
+
+	return ""
+}
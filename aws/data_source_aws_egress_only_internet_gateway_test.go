@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsEgressOnlyInternetGateway_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_egress_only_internet_gateway.test"
+	vpcResourceName := "aws_vpc.test"
+	ds1ResourceName := "data.aws_egress_only_internet_gateway.by_id"
+	ds2ResourceName := "data.aws_egress_only_internet_gateway.by_vpc_id"
+	ds3ResourceName := "data.aws_egress_only_internet_gateway.by_tags"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEgressOnlyInternetGatewayConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "egress_only_internet_gateway_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "vpc_id", vpcResourceName, "id"),
+					testAccMatchResourceAttrRegionalARN(ds1ResourceName, "arn", "ec2", regexp.MustCompile(`egress-only-internet-gateway/eigw-.+`)),
+					resource.TestCheckResourceAttr(ds1ResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(ds1ResourceName, "tags.Name", rName),
+
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "egress_only_internet_gateway_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "vpc_id", vpcResourceName, "id"),
+
+					resource.TestCheckResourceAttrPair(ds3ResourceName, "egress_only_internet_gateway_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds3ResourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsEgressOnlyInternetGateway_noMatch(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsEgressOnlyInternetGatewayConfigNoMatch(rName),
+				ExpectError: regexp.MustCompile(`no matching EC2 Egress Only Internet Gateway found`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEgressOnlyInternetGatewayConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_egress_only_internet_gateway" "by_id" {
+  egress_only_internet_gateway_id = aws_egress_only_internet_gateway.test.id
+}
+
+data "aws_egress_only_internet_gateway" "by_vpc_id" {
+  vpc_id = aws_egress_only_internet_gateway.test.vpc_id
+}
+
+data "aws_egress_only_internet_gateway" "by_tags" {
+  tags = {
+    Name = aws_egress_only_internet_gateway.test.tags["Name"]
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAwsEgressOnlyInternetGatewayConfigNoMatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
@@ -46,6 +46,11 @@ func dataSourceAwsInternetGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -58,13 +63,15 @@ func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{})
 	internetGatewayId, internetGatewayIdOk := d.GetOk("internet_gateway_id")
 	tags, tagsOk := d.GetOk("tags")
 	filter, filterOk := d.GetOk("filter")
+	vpcId, vpcIdOk := d.GetOk("vpc_id")
 
-	if !internetGatewayIdOk && !filterOk && !tagsOk {
-		return fmt.Errorf("One of internet_gateway_id or filter or tags must be assigned")
+	if !internetGatewayIdOk && !filterOk && !tagsOk && !vpcIdOk {
+		return fmt.Errorf("One of internet_gateway_id, vpc_id, filter or tags must be assigned")
 	}
 
 	req.Filters = buildEC2AttributeFilterList(map[string]string{
 		"internet-gateway-id": internetGatewayId.(string),
+		"attachment.vpc-id":   vpcId.(string),
 	})
 	req.Filters = append(req.Filters, buildEC2TagFilterList(
 		keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
@@ -79,11 +86,14 @@ func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	if resp == nil || len(resp.InternetGateways) == 0 {
+	if resp == nil || len(resp.InternetGateways) == 0 || resp.InternetGateways[0] == nil {
+		if vpcIdOk {
+			return fmt.Errorf("no Internet Gateway matching the search criteria is attached to VPC (%s)", vpcId.(string))
+		}
 		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
 	}
 	if len(resp.InternetGateways) > 1 {
-		return fmt.Errorf("Multiple Internet Gateways matched; use additional constraints to reduce matches to a single Internet Gateway")
+		return fmt.Errorf("Multiple Internet Gateways (%d) matched; use additional constraints to reduce matches to a single Internet Gateway", len(resp.InternetGateways))
 	}
 
 	igw := resp.InternetGateways[0]
@@ -96,6 +106,8 @@ func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("owner_id", igw.OwnerId)
 	d.Set("internet_gateway_id", igw.InternetGatewayId)
 
+	d.Set("vpc_id", internetGatewayAttachedVpcID(igw))
+
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
 		Service:   ec2.ServiceName,
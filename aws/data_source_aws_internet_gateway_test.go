@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -12,6 +15,7 @@ func TestAccDataSourceAwsInternetGateway_typical(t *testing.T) {
 	ds1ResourceName := "data.aws_internet_gateway.by_id"
 	ds2ResourceName := "data.aws_internet_gateway.by_filter"
 	ds3ResourceName := "data.aws_internet_gateway.by_tags"
+	ds4ResourceName := "data.aws_internet_gateway.by_vpc_id"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -32,12 +36,34 @@ func TestAccDataSourceAwsInternetGateway_typical(t *testing.T) {
 					resource.TestCheckResourceAttrPair(ds3ResourceName, "internet_gateway_id", igwResourceName, "id"),
 					resource.TestCheckResourceAttrPair(ds3ResourceName, "owner_id", igwResourceName, "owner_id"),
 					resource.TestCheckResourceAttrPair(ds3ResourceName, "attachments.0.vpc_id", vpcResourceName, "id"),
+
+					resource.TestCheckResourceAttrPair(ds4ResourceName, "internet_gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds4ResourceName, "owner_id", igwResourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(ds4ResourceName, "arn", igwResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(ds4ResourceName, "vpc_id", vpcResourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds4ResourceName, "tags.%", igwResourceName, "tags.%"),
+					resource.TestCheckResourceAttr(ds4ResourceName, "attachments.0.state", "available"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDataSourceAwsInternetGateway_noMatchingVpc(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsInternetGatewayConfigNoMatchingVpc(rName),
+				ExpectError: regexp.MustCompile(`no Internet Gateway matching the search criteria is attached to VPC`),
+			},
+		},
+	})
+}
+
 const testAccDataSourceAwsInternetGatewayConfig = `
 resource "aws_vpc" "test" {
   cidr_block = "172.16.0.0/16"
@@ -71,4 +97,24 @@ data "aws_internet_gateway" "by_filter" {
     values = [aws_internet_gateway.test.id]
   }
 }
+
+data "aws_internet_gateway" "by_vpc_id" {
+  vpc_id = aws_internet_gateway.test.vpc_id
+}
 `
+
+func testAccDataSourceAwsInternetGatewayConfigNoMatchingVpc(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
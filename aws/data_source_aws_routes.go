@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func dataSourceAwsRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.RouteState_Values(), false),
+			},
+			"destination_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destination_ipv6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destination_prefix_list_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	routeTableID := d.Get("route_table_id").(string)
+
+	routeTable, err := finder.RouteTableByID(conn, routeTableID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", routeTableID, err)
+	}
+
+	if routeTable == nil {
+		return fmt.Errorf("error reading Route Table (%s): not found", routeTableID)
+	}
+
+	var cidrBlocks, ipv6CidrBlocks, prefixListIDs []string
+
+	for _, route := range routeTable.Routes {
+		if route == nil {
+			continue
+		}
+
+		if !routeStateMatches(route, d.Get("state").(string)) {
+			continue
+		}
+
+		if v := aws.StringValue(route.DestinationCidrBlock); v != "" {
+			cidrBlocks = append(cidrBlocks, v)
+		}
+
+		if v := aws.StringValue(route.DestinationIpv6CidrBlock); v != "" {
+			ipv6CidrBlocks = append(ipv6CidrBlocks, v)
+		}
+
+		if v := aws.StringValue(route.DestinationPrefixListId); v != "" {
+			prefixListIDs = append(prefixListIDs, v)
+		}
+	}
+
+	sort.Strings(cidrBlocks)
+	sort.Strings(ipv6CidrBlocks)
+	sort.Strings(prefixListIDs)
+
+	d.SetId(routeTableID)
+
+	if err := d.Set("destination_cidr_blocks", cidrBlocks); err != nil {
+		return fmt.Errorf("error setting destination_cidr_blocks: %w", err)
+	}
+
+	if err := d.Set("destination_ipv6_cidr_blocks", ipv6CidrBlocks); err != nil {
+		return fmt.Errorf("error setting destination_ipv6_cidr_blocks: %w", err)
+	}
+
+	if err := d.Set("destination_prefix_list_ids", prefixListIDs); err != nil {
+		return fmt.Errorf("error setting destination_prefix_list_ids: %w", err)
+	}
+
+	return nil
+}
+
+// routeStateMatches returns whether the route is in the specified state (active or blackhole).
+// An empty state matches every route.
+func routeStateMatches(route *ec2.Route, state string) bool {
+	return state == "" || aws.StringValue(route.State) == state
+}
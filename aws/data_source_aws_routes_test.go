@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSRoutesDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	allDataSourceName := "data.aws_routes.all"
+	activeDataSourceName := "data.aws_routes.active"
+	blackholeDataSourceName := "data.aws_routes.blackhole"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoutesDataSourceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(allDataSourceName, "destination_cidr_blocks.#", "3"),
+					resource.TestCheckResourceAttr(allDataSourceName, "destination_cidr_blocks.0", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(allDataSourceName, "destination_cidr_blocks.1", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(allDataSourceName, "destination_cidr_blocks.2", "10.3.0.0/16"),
+					resource.TestCheckResourceAttr(allDataSourceName, "destination_ipv6_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(allDataSourceName, "destination_prefix_list_ids.#", "0"),
+					resource.TestCheckResourceAttr(activeDataSourceName, "destination_cidr_blocks.#", "3"),
+					resource.TestCheckResourceAttr(blackholeDataSourceName, "destination_cidr_blocks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoutesDataSourceConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test1" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
+}
+
+resource "aws_route" "test2" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.2.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
+}
+
+data "aws_routes" "all" {
+  route_table_id = aws_route_table.test.id
+
+  depends_on = [aws_route.test1, aws_route.test2]
+}
+
+data "aws_routes" "active" {
+  route_table_id = aws_route_table.test.id
+  state          = "active"
+
+  depends_on = [aws_route.test1, aws_route.test2]
+}
+
+data "aws_routes" "blackhole" {
+  route_table_id = aws_route_table.test.id
+  state          = "blackhole"
+
+  depends_on = [aws_route.test1, aws_route.test2]
+}
+`, rName)
+}
@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsSubnets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSubnetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeSubnetsInput{}
+
+	input.Filters = buildEC2AttributeFilterList(map[string]string{
+		"vpc-id": d.Get("vpc_id").(string),
+	})
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filters = append(input.Filters, buildEC2TagFilterList(
+			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
+		)...)
+	}
+
+	if filters, ok := d.GetOk("filter"); ok {
+		input.Filters = append(input.Filters, buildEC2CustomFilterList(
+			filters.(*schema.Set),
+		)...)
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Subnets: %s", input)
+	var subnets []*ec2.Subnet
+
+	err := conn.DescribeSubnetsPages(input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, subnet := range page.Subnets {
+			if subnet == nil {
+				continue
+			}
+
+			subnets = append(subnets, subnet)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Subnets: %w", err)
+	}
+
+	// Order the results so that the parallel lists are stable across reads.
+	sort.Slice(subnets, func(i, j int) bool {
+		return aws.StringValue(subnets[i].SubnetId) < aws.StringValue(subnets[j].SubnetId)
+	})
+
+	arns := make([]string, 0, len(subnets))
+	availabilityZones := make([]string, 0, len(subnets))
+	cidrBlocks := make([]string, 0, len(subnets))
+	ids := make([]string, 0, len(subnets))
+	ipv6CidrBlocks := make([]string, 0, len(subnets))
+
+	for _, subnet := range subnets {
+		arns = append(arns, aws.StringValue(subnet.SubnetArn))
+		availabilityZones = append(availabilityZones, aws.StringValue(subnet.AvailabilityZone))
+		cidrBlocks = append(cidrBlocks, aws.StringValue(subnet.CidrBlock))
+		ids = append(ids, aws.StringValue(subnet.SubnetId))
+		ipv6CidrBlocks = append(ipv6CidrBlocks, dataSourceAwsSubnetsIpv6CidrBlock(subnet))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("availability_zones", availabilityZones); err != nil {
+		return fmt.Errorf("error setting availability_zones: %w", err)
+	}
+
+	if err := d.Set("cidr_blocks", cidrBlocks); err != nil {
+		return fmt.Errorf("error setting cidr_blocks: %w", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("ipv6_cidr_blocks", ipv6CidrBlocks); err != nil {
+		return fmt.Errorf("error setting ipv6_cidr_blocks: %w", err)
+	}
+
+	return nil
+}
+
+// dataSourceAwsSubnetsIpv6CidrBlock returns the subnet's associated IPv6 CIDR block, or an empty string if there is none.
+func dataSourceAwsSubnetsIpv6CidrBlock(subnet *ec2.Subnet) string {
+	for _, association := range subnet.Ipv6CidrBlockAssociationSet {
+		if association == nil || association.Ipv6CidrBlockState == nil {
+			continue
+		}
+
+		if aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.SubnetCidrBlockStateCodeAssociated {
+			return aws.StringValue(association.Ipv6CidrBlock)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsSubnets_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_subnets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSubnetsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_subnets.private", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets.private", "ids.0", "aws_subnet.private", "id"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets.private", "arns.0", "aws_subnet.private", "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets.private", "availability_zones.0", "aws_subnet.private", "availability_zone"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets.private", "cidr_blocks.0", "aws_subnet.private", "cidr_block"),
+					resource.TestCheckResourceAttrPair("data.aws_subnets.private", "ipv6_cidr_blocks.0", "aws_subnet.private", "ipv6_cidr_block"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsSubnets_noMatches(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_subnets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSubnetsConfigNoMatches(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsSubnetsConfig(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "public" {
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = %[1]q
+    Tier = "Public"
+  }
+}
+
+resource "aws_subnet" "private" {
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "10.1.2.0/24"
+  ipv6_cidr_block   = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  availability_zone = data.aws_availability_zones.available.names[1]
+
+  tags = {
+    Name = %[1]q
+    Tier = "Private"
+  }
+}
+
+data "aws_subnets" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_subnet.public, aws_subnet.private]
+}
+
+data "aws_subnets" "private" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Tier = "Private"
+  }
+
+  depends_on = [aws_subnet.public, aws_subnet.private]
+}
+`, rName))
+}
+
+func testAccDataSourceAwsSubnetsConfigNoMatches(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_subnets" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
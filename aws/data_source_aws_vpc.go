@@ -94,6 +94,35 @@ func dataSourceAwsVpc() *schema.Resource {
 				Computed: true,
 			},
 
+			"ipv6_cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_pool": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_border_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"main_route_table_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -201,10 +230,16 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 
 	cidrAssociations := []interface{}{}
 	for _, associationSet := range vpc.CidrBlockAssociationSet {
+		if associationSet == nil {
+			continue
+		}
+
 		association := map[string]interface{}{
 			"association_id": aws.StringValue(associationSet.AssociationId),
 			"cidr_block":     aws.StringValue(associationSet.CidrBlock),
-			"state":          aws.StringValue(associationSet.CidrBlockState.State),
+		}
+		if associationSet.CidrBlockState != nil {
+			association["state"] = aws.StringValue(associationSet.CidrBlockState.State)
 		}
 		cidrAssociations = append(cidrAssociations, association)
 	}
@@ -212,7 +247,28 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting cidr_block_associations: %w", err)
 	}
 
-	if vpc.Ipv6CidrBlockAssociationSet != nil {
+	ipv6CidrAssociations := []interface{}{}
+	for _, associationSet := range vpc.Ipv6CidrBlockAssociationSet {
+		if associationSet == nil {
+			continue
+		}
+
+		association := map[string]interface{}{
+			"association_id":       aws.StringValue(associationSet.AssociationId),
+			"ipv6_cidr_block":      aws.StringValue(associationSet.Ipv6CidrBlock),
+			"ipv6_pool":            aws.StringValue(associationSet.Ipv6Pool),
+			"network_border_group": aws.StringValue(associationSet.NetworkBorderGroup),
+		}
+		if associationSet.Ipv6CidrBlockState != nil {
+			association["state"] = aws.StringValue(associationSet.Ipv6CidrBlockState.State)
+		}
+		ipv6CidrAssociations = append(ipv6CidrAssociations, association)
+	}
+	if err := d.Set("ipv6_cidr_block_associations", ipv6CidrAssociations); err != nil {
+		return fmt.Errorf("error setting ipv6_cidr_block_associations: %w", err)
+	}
+
+	if len(vpc.Ipv6CidrBlockAssociationSet) > 0 && vpc.Ipv6CidrBlockAssociationSet[0] != nil {
 		d.Set("ipv6_association_id", vpc.Ipv6CidrBlockAssociationSet[0].AssociationId)
 		d.Set("ipv6_cidr_block", vpc.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
 	}
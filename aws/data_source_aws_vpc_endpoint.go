@@ -96,6 +96,34 @@ func dataSourceAwsVpcEndpoint() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"subnet_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"subnet_ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -223,6 +251,14 @@ func dataSourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("error setting subnet_ids: %w", err)
 	}
+	subnetConfigurations, err := readVpcEndpointSubnetConfigurations(conn, vpce.NetworkInterfaceIds)
+	if err != nil {
+		return fmt.Errorf("error reading VPC Endpoint (%s) network interfaces: %w", d.Id(), err)
+	}
+	err = d.Set("subnet_configuration", subnetConfigurations)
+	if err != nil {
+		return fmt.Errorf("error setting subnet_configuration: %w", err)
+	}
 	err = d.Set("tags", keyvaluetags.Ec2KeyValueTags(vpce.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 	if err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
@@ -172,6 +172,9 @@ func TestAccDataSourceAwsVpcEndpoint_interface(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "route_table_ids.#", "0"),
 					resource.TestCheckResourceAttr(datasourceName, "subnet_ids.#", "1"),
 					resource.TestCheckResourceAttr(datasourceName, "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "subnet_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "subnet_configuration.0.subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "subnet_configuration.0.ipv4"),
 					resource.TestCheckResourceAttr(datasourceName, "security_group_ids.#", "1"),
 					resource.TestCheckResourceAttr(datasourceName, "private_dns_enabled", "false"),
 					resource.TestCheckResourceAttr(datasourceName, "requester_managed", "false"),
@@ -52,6 +52,18 @@ func dataSourceAwsVpcPeeringConnection() *schema.Resource {
 					},
 				},
 			},
+			"ipv6_cidr_block_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -84,6 +96,18 @@ func dataSourceAwsVpcPeeringConnection() *schema.Resource {
 					},
 				},
 			},
+			"peer_ipv6_cidr_block_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"peer_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -172,6 +196,16 @@ func dataSourceAwsVpcPeeringConnectionRead(d *schema.ResourceData, meta interfac
 	if err := d.Set("cidr_block_set", cidrBlockSet); err != nil {
 		return fmt.Errorf("error setting cidr_block_set: %w", err)
 	}
+	ipv6CidrBlockSet := []interface{}{}
+	for _, associationSet := range pcx.RequesterVpcInfo.Ipv6CidrBlockSet {
+		association := map[string]interface{}{
+			"ipv6_cidr_block": aws.StringValue(associationSet.Ipv6CidrBlock),
+		}
+		ipv6CidrBlockSet = append(ipv6CidrBlockSet, association)
+	}
+	if err := d.Set("ipv6_cidr_block_set", ipv6CidrBlockSet); err != nil {
+		return fmt.Errorf("error setting ipv6_cidr_block_set: %w", err)
+	}
 	d.Set("region", pcx.RequesterVpcInfo.Region)
 	d.Set("peer_vpc_id", pcx.AccepterVpcInfo.VpcId)
 	d.Set("peer_owner_id", pcx.AccepterVpcInfo.OwnerId)
@@ -186,6 +220,16 @@ func dataSourceAwsVpcPeeringConnectionRead(d *schema.ResourceData, meta interfac
 	if err := d.Set("peer_cidr_block_set", peerCidrBlockSet); err != nil {
 		return fmt.Errorf("error setting peer_cidr_block_set: %w", err)
 	}
+	peerIpv6CidrBlockSet := []interface{}{}
+	for _, associationSet := range pcx.AccepterVpcInfo.Ipv6CidrBlockSet {
+		association := map[string]interface{}{
+			"ipv6_cidr_block": aws.StringValue(associationSet.Ipv6CidrBlock),
+		}
+		peerIpv6CidrBlockSet = append(peerIpv6CidrBlockSet, association)
+	}
+	if err := d.Set("peer_ipv6_cidr_block_set", peerIpv6CidrBlockSet); err != nil {
+		return fmt.Errorf("error setting peer_ipv6_cidr_block_set: %w", err)
+	}
 	d.Set("peer_region", pcx.AccepterVpcInfo.Region)
 	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(pcx.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
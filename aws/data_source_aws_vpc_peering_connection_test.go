@@ -45,12 +45,14 @@ func TestAccDataSourceAwsVpcPeeringConnection_Id(t *testing.T) {
 					// resource.TestCheckResourceAttrPair(dataSourceName, "cidr_block_set.#", resourceName, "cidr_block_set.#"), // not in resource
 					resource.TestCheckResourceAttr(dataSourceName, "cidr_block_set.#", "1"),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "cidr_block_set.*.cidr_block", requesterVpcResourceName, "cidr_block"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_block_set.#", "0"),
 					// resource.TestCheckResourceAttrPair(dataSourceName, "region", resourceName, "region"), // not in resource
 					// resource.TestCheckResourceAttrPair(dataSourceName, "peer_cidr_block", resourceName, "peer_cidr_block"), // not in resource
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_cidr_block", accepterVpcResourceName, "cidr_block"),
 					// resource.TestCheckResourceAttrPair(dataSourceName, "peer_cidr_block_set.#", resourceName, "peer_cidr_block_set.#"), // not in resource
 					resource.TestCheckResourceAttr(dataSourceName, "peer_cidr_block_set.#", "1"),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "peer_cidr_block_set.*.cidr_block", accepterVpcResourceName, "cidr_block"),
+					resource.TestCheckResourceAttr(dataSourceName, "peer_ipv6_cidr_block_set.#", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_owner_id", resourceName, "peer_owner_id"),
 					// resource.TestCheckResourceAttrPair(dataSourceName, "peer_region", resourceName, "peer_region"), //not in resource
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_vpc_id", resourceName, "peer_vpc_id"),
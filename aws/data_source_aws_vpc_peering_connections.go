@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -14,13 +15,20 @@ func dataSourceAwsVpcPeeringConnections() *schema.Resource {
 		Read: dataSourceAwsVpcPeeringConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
+			"accepter_vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"filter": ec2CustomFiltersSchema(),
-			"tags":   tagsSchemaComputed(),
+			"requester_vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchemaComputed(),
 			"ids": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
 			},
 		},
 	}
@@ -31,6 +39,12 @@ func dataSourceAwsVpcPeeringConnectionsRead(d *schema.ResourceData, meta interfa
 
 	req := &ec2.DescribeVpcPeeringConnectionsInput{}
 
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"accepter-vpc-info.vpc-id":  d.Get("accepter_vpc_id").(string),
+			"requester-vpc-info.vpc-id": d.Get("requester_vpc_id").(string),
+		},
+	)
 	req.Filters = append(req.Filters, buildEC2TagFilterList(
 		keyvaluetags.New(d.Get("tags").(map[string]interface{})).Ec2Tags(),
 	)...)
@@ -42,24 +56,33 @@ func dataSourceAwsVpcPeeringConnectionsRead(d *schema.ResourceData, meta interfa
 		req.Filters = nil
 	}
 
-	resp, err := conn.DescribeVpcPeeringConnections(req)
+	var ids []string
+	err := conn.DescribeVpcPeeringConnectionsPages(req, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, pcx := range page.VpcPeeringConnections {
+			if pcx == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(pcx.VpcPeeringConnectionId))
+		}
+
+		return !lastPage
+	})
+
 	if err != nil {
-		return err
-	}
-	if resp == nil || len(resp.VpcPeeringConnections) == 0 {
-		return fmt.Errorf("no matching VPC peering connections found")
+		return fmt.Errorf("error reading VPC Peering Connections: %w", err)
 	}
 
-	var ids []string
-	for _, pcx := range resp.VpcPeeringConnections {
-		ids = append(ids, aws.StringValue(pcx.VpcPeeringConnectionId))
-	}
+	sort.Strings(ids)
 
 	d.SetId(meta.(*AWSClient).region)
 
-	err = d.Set("ids", ids)
-	if err != nil {
-		return err
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
 	}
 
 	return nil
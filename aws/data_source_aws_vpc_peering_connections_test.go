@@ -15,6 +15,10 @@ func TestAccDataSourceAwsVpcPeeringConnections_basic(t *testing.T) {
 				Config: testAccDataSourceAwsVpcPeeringConnectionsConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_by_filters", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_by_requester_vpc_id", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_by_accepter_vpc_id", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_vpc_peering_connections.test_by_accepter_vpc_id", "ids.0", "aws_vpc_peering_connection.conn1", "id"),
+					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_empty", "ids.#", "0"),
 				),
 			},
 		},
@@ -77,4 +81,22 @@ data "aws_vpc_peering_connections" "test_by_filters" {
     values = [aws_vpc_peering_connection.conn1.id, aws_vpc_peering_connection.conn2.id]
   }
 }
+
+data "aws_vpc_peering_connections" "test_by_requester_vpc_id" {
+  requester_vpc_id = aws_vpc.foo.id
+
+  depends_on = [aws_vpc_peering_connection.conn1, aws_vpc_peering_connection.conn2]
+}
+
+data "aws_vpc_peering_connections" "test_by_accepter_vpc_id" {
+  accepter_vpc_id = aws_vpc.bar.id
+
+  depends_on = [aws_vpc_peering_connection.conn1, aws_vpc_peering_connection.conn2]
+}
+
+data "aws_vpc_peering_connections" "test_empty" {
+  requester_vpc_id = aws_vpc.baz.id
+
+  depends_on = [aws_vpc_peering_connection.conn1, aws_vpc_peering_connection.conn2]
+}
 `
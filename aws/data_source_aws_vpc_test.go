@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -105,6 +106,18 @@ func TestAccDataSourceAwsVpc_ipv6Associated(t *testing.T) {
 						"data.aws_vpc.by_id", "ipv6_association_id"),
 					resource.TestCheckResourceAttrSet(
 						"data.aws_vpc.by_id", "ipv6_cidr_block"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpc.by_id", "ipv6_cidr_block_associations.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpc.by_id", "ipv6_cidr_block_associations.0.association_id", vpcResourceName, "ipv6_association_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpc.by_id", "ipv6_cidr_block_associations.0.ipv6_cidr_block", vpcResourceName, "ipv6_cidr_block"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpc.by_id", "ipv6_cidr_block_associations.0.ipv6_pool", "Amazon"),
+					resource.TestCheckResourceAttrSet(
+						"data.aws_vpc.by_id", "ipv6_cidr_block_associations.0.network_border_group"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpc.by_id", "ipv6_cidr_block_associations.0.state", ec2.VpcCidrBlockStateCodeAssociated),
 				),
 			},
 		},
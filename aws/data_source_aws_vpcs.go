@@ -3,8 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -14,8 +17,31 @@ func dataSourceAwsVpcs() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceAwsVpcsRead,
 		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIpv4CIDRNetworkAddress,
+			},
+
+			"cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"filter": ec2CustomFiltersSchema(),
 
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"tags": tagsSchemaComputed(),
 
 			"ids": {
@@ -33,6 +59,19 @@ func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
 
 	req := &ec2.DescribeVpcsInput{}
 
+	isDefault := ""
+	// Unlike the aws_vpc data source, false filters for VPCs that are not the default VPC.
+	if v, ok := d.GetOkExists("is_default"); ok {
+		isDefault = strconv.FormatBool(v.(bool))
+	}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"cidr":      d.Get("cidr_block").(string),
+			"isDefault": isDefault,
+		},
+	)
+
 	if tags, tagsOk := d.GetOk("tags"); tagsOk {
 		req.Filters = append(req.Filters, buildEC2TagFilterList(
 			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
@@ -50,24 +89,66 @@ func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] DescribeVpcs %s\n", req)
-	resp, err := conn.DescribeVpcs(req)
+	var vpcs []*ec2.Vpc
+
+	err := conn.DescribeVpcsPages(req, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, vpc := range page.Vpcs {
+			if vpc == nil {
+				continue
+			}
+
+			vpcs = append(vpcs, vpc)
+		}
+
+		return !lastPage
+	})
+
 	if err != nil {
-		return err
+		return fmt.Errorf("error describing EC2 VPCs: %w", err)
 	}
 
-	if resp == nil || len(resp.Vpcs) == 0 {
+	if len(vpcs) == 0 {
 		return fmt.Errorf("no matching VPC found")
 	}
 
-	vpcs := make([]string, 0)
-
-	for _, vpc := range resp.Vpcs {
-		vpcs = append(vpcs, aws.StringValue(vpc.VpcId))
+	// Order the lists by VPC ID, the order in which Terraform lists the ids set.
+	sort.Slice(vpcs, func(i, j int) bool {
+		return aws.StringValue(vpcs[i].VpcId) < aws.StringValue(vpcs[j].VpcId)
+	})
+
+	arns := make([]string, 0, len(vpcs))
+	cidrBlocks := make([]string, 0, len(vpcs))
+	ids := make([]string, 0, len(vpcs))
+
+	for _, vpc := range vpcs {
+		id := aws.StringValue(vpc.VpcId)
+
+		arns = append(arns, arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Service:   ec2.ServiceName,
+			Region:    meta.(*AWSClient).region,
+			AccountID: aws.StringValue(vpc.OwnerId),
+			Resource:  fmt.Sprintf("vpc/%s", id),
+		}.String())
+		cidrBlocks = append(cidrBlocks, aws.StringValue(vpc.CidrBlock))
+		ids = append(ids, id)
 	}
 
 	d.SetId(meta.(*AWSClient).region)
 
-	if err := d.Set("ids", vpcs); err != nil {
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("cidr_blocks", cidrBlocks); err != nil {
+		return fmt.Errorf("error setting cidr_blocks: %w", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
 		return fmt.Errorf("error setting vpc ids: %w", err)
 	}
 
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -35,6 +36,10 @@ func TestAccDataSourceAwsVpcs_tags(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsVpcsDataSourceExists("data.aws_vpcs.selected"),
 					resource.TestCheckResourceAttr("data.aws_vpcs.selected", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.selected", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.selected", "arns.0", "aws_vpc.test-vpc", "arn"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.selected", "cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.selected", "cidr_blocks.0", "10.0.0.0/24"),
 				),
 			},
 		},
@@ -58,6 +63,52 @@ func TestAccDataSourceAwsVpcs_filters(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAwsVpcs_CidrBlock(t *testing.T) {
+	dataSourceName := "data.aws_vpcs.selected"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcsConfigCidrBlock(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", vpcResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", vpcResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cidr_blocks.0", vpcResourceName, "cidr_block"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsVpcs_IsDefault(t *testing.T) {
+	dataSourceName := "data.aws_vpcs.selected"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcsConfigIsDefault(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", vpcResourceName, "id"),
+				),
+			},
+			{
+				Config:      testAccDataSourceAwsVpcsConfigIsDefault(rName, true),
+				ExpectError: regexp.MustCompile(`no matching VPC found`),
+			},
+		},
+	})
+}
+
 func testCheckResourceAttrGreaterThanValue(name, key, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ms := s.RootModule()
@@ -150,3 +201,43 @@ data "aws_vpcs" "selected" {
 }
 `, rName)
 }
+
+func testAccDataSourceAwsVpcsConfigCidrBlock(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.100.0/26"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpcs" "selected" {
+  cidr_block = aws_vpc.test.cidr_block
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAwsVpcsConfigIsDefault(rName string, isDefault bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.101.0/26"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpcs" "selected" {
+  is_default = %[2]t
+
+  tags = {
+    Name = aws_vpc.test.tags["Name"]
+  }
+}
+`, rName, isDefault)
+}
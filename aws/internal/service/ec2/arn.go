@@ -0,0 +1,45 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	ARNSeparator = "/"
+	ARNService   = "ec2"
+
+	TransitGatewayResourcePrefix = "transit-gateway"
+)
+
+// TransitGatewayIDFromARNOrID returns the transit gateway identifier from either
+// a transit gateway Amazon Resource Name (ARN) or a transit gateway identifier.
+func TransitGatewayIDFromARNOrID(input string) (string, error) {
+	if !arn.IsARN(input) {
+		return input, nil
+	}
+
+	parsedARN, err := arn.Parse(input)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", input, err)
+	}
+
+	if actual, expected := parsedARN.Service, ARNService; actual != expected {
+		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, input, actual)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual, expected := len(resourceParts), 2; actual != expected {
+		return "", fmt.Errorf("expected %d resource parts in ARN (%s), got: %d", expected, input, actual)
+	}
+
+	if actual, expected := resourceParts[0], TransitGatewayResourcePrefix; actual != expected {
+		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, input, actual)
+	}
+
+	return resourceParts[1], nil
+}
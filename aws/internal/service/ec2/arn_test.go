@@ -0,0 +1,65 @@
+package ec2_test
+
+import (
+	"regexp"
+	"testing"
+
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+)
+
+func TestTransitGatewayIDFromARNOrID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Input         string
+		ExpectedError *regexp.Regexp
+		ExpectedID    string
+	}{
+		{
+			TestName:   "identifier",
+			Input:      "tgw-12345678",
+			ExpectedID: "tgw-12345678",
+		},
+		{
+			TestName:      "invalid ARN service",
+			Input:         "arn:aws:iam::123456789012:role/tgw-12345678",
+			ExpectedError: regexp.MustCompile(`expected service ec2`),
+		},
+		{
+			TestName:      "invalid ARN resource parts",
+			Input:         "arn:aws:ec2:us-east-1:123456789012:tgw-12345678",
+			ExpectedError: regexp.MustCompile(`expected 2 resource parts`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			Input:         "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-12345678",
+			ExpectedError: regexp.MustCompile(`expected resource prefix transit-gateway`),
+		},
+		{
+			TestName:   "valid ARN",
+			Input:      "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-12345678",
+			ExpectedID: "tgw-12345678",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfec2.TransitGatewayIDFromARNOrID(testCase.Input)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
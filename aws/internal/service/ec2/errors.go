@@ -10,6 +10,8 @@ import (
 
 const (
 	ErrCodeInvalidParameterValue = "InvalidParameterValue"
+	ErrCodeOperationNotPermitted = "OperationNotPermitted"
+	ErrCodeUnsupportedOperation  = "UnsupportedOperation"
 )
 
 const (
@@ -21,7 +23,9 @@ const (
 )
 
 const (
+	ErrCodeInvalidRouteNotFound        = "InvalidRoute.NotFound"
 	ErrCodeInvalidRouteTableIDNotFound = "InvalidRouteTableID.NotFound"
+	ErrCodeRouteAlreadyExists          = "RouteAlreadyExists"
 )
 
 const (
@@ -35,6 +39,11 @@ const (
 	ErrCodeInvalidInstanceIDNotFound = "InvalidInstanceID.NotFound"
 )
 
+const (
+	ErrCodeGatewayNotAttached               = "Gateway.NotAttached"
+	ErrCodeInvalidInternetGatewayIDNotFound = "InvalidInternetGatewayID.NotFound"
+)
+
 const (
 	InvalidSecurityGroupIDNotFound = "InvalidSecurityGroupID.NotFound"
 	InvalidGroupNotFound           = "InvalidGroup.NotFound"
@@ -45,7 +54,8 @@ const (
 )
 
 const (
-	ErrCodeInvalidVpcIDNotFound = "InvalidVpcID.NotFound"
+	ErrCodeInvalidVpcCidrBlockAssociationIDNotFound = "InvalidVpcCidrBlockAssociationID.NotFound"
+	ErrCodeInvalidVpcIDNotFound                     = "InvalidVpcID.NotFound"
 )
 
 const (
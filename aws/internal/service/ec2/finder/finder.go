@@ -95,6 +95,92 @@ func InstanceByID(conn *ec2.EC2, id string) (*ec2.Instance, error) {
 	return output.Reservations[0].Instances[0], nil
 }
 
+// NetworkInterfacesByIDs returns the network interfaces corresponding to the specified identifiers.
+func NetworkInterfacesByIDs(conn *ec2.EC2, ids []*string) ([]*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: ids,
+	}
+
+	var networkInterfaces []*ec2.NetworkInterface
+
+	err := conn.DescribeNetworkInterfacesPages(input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, networkInterface := range page.NetworkInterfaces {
+			if networkInterface == nil {
+				continue
+			}
+
+			networkInterfaces = append(networkInterfaces, networkInterface)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return networkInterfaces, nil
+}
+
+// NetworkInterfacesBySubnetID returns the network interfaces in the specified subnet.
+func NetworkInterfacesBySubnetID(conn *ec2.EC2, subnetID string) ([]*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("subnet-id"),
+				Values: aws.StringSlice([]string{subnetID}),
+			},
+		},
+	}
+
+	var networkInterfaces []*ec2.NetworkInterface
+
+	err := conn.DescribeNetworkInterfacesPages(input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, networkInterface := range page.NetworkInterfaces {
+			if networkInterface == nil {
+				continue
+			}
+
+			networkInterfaces = append(networkInterfaces, networkInterface)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return networkInterfaces, nil
+}
+
+// RouteTableByID returns the route table corresponding to the specified identifier.
+// Returns nil and potentially an error if no route table is found.
+func RouteTableByID(conn *ec2.EC2, id string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		RouteTableIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeRouteTables(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
+		return nil, nil
+	}
+
+	return output.RouteTables[0], nil
+}
+
 // SecurityGroupByID looks up a security group by ID. When not found, returns nil and potentially an API error.
 func SecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
@@ -229,6 +315,25 @@ func VpcPeeringConnectionByID(conn *ec2.EC2, id string) (*ec2.VpcPeeringConnecti
 	return output.VpcPeeringConnections[0], nil
 }
 
+// VpcEndpointServiceConfigurationByID returns the VPC endpoint service configuration corresponding to the specified identifier.
+// Returns nil and potentially an error if no VPC endpoint service configuration is found.
+func VpcEndpointServiceConfigurationByID(conn *ec2.EC2, id string) (*ec2.ServiceConfiguration, error) {
+	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{
+		ServiceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeVpcEndpointServiceConfigurations(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServiceConfigurations) == 0 {
+		return nil, nil
+	}
+
+	return output.ServiceConfigurations[0], nil
+}
+
 // VpnGatewayVpcAttachment returns the attachment between the specified VPN gateway and VPC.
 // Returns nil and potentially an error if no attachment is found.
 func VpnGatewayVpcAttachment(conn *ec2.EC2, vpnGatewayID, vpcID string) (*ec2.VpcAttachment, error) {
@@ -389,6 +389,30 @@ func VpcPeeringConnectionStatus(conn *ec2.EC2, vpcPeeringConnectionID string) re
 	}
 }
 
+const (
+	vpcEndpointServicePrivateDnsNameStateNotFound = "NotFound"
+	vpcEndpointServicePrivateDnsNameStateUnknown  = "Unknown"
+)
+
+// VpcEndpointServicePrivateDnsNameState fetches the VPC endpoint service configuration and the verification state of its private DNS name
+func VpcEndpointServicePrivateDnsNameState(conn *ec2.EC2, serviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		serviceConfiguration, err := finder.VpcEndpointServiceConfigurationByID(conn, serviceID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcEndpointServiceIdNotFound) {
+			return nil, vpcEndpointServicePrivateDnsNameStateNotFound, nil
+		}
+		if err != nil {
+			return nil, vpcEndpointServicePrivateDnsNameStateUnknown, err
+		}
+
+		if serviceConfiguration == nil || serviceConfiguration.PrivateDnsNameConfiguration == nil {
+			return nil, vpcEndpointServicePrivateDnsNameStateNotFound, nil
+		}
+
+		return serviceConfiguration, aws.StringValue(serviceConfiguration.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 const (
 	attachmentStateNotFound = "NotFound"
 	attachmentStateUnknown  = "Unknown"
@@ -488,3 +488,24 @@ func ManagedPrefixListDeleted(conn *ec2.EC2, prefixListId string) error {
 
 	return nil
 }
+
+const (
+	VpcEndpointServicePrivateDnsNameVerifiedTimeout = 30 * time.Minute
+)
+
+func VpcEndpointServicePrivateDnsNameVerified(conn *ec2.EC2, serviceID string, timeout time.Duration) (*ec2.ServiceConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.DnsNameStatePendingVerification},
+		Target:  []string{ec2.DnsNameStateVerified},
+		Refresh: VpcEndpointServicePrivateDnsNameState(conn, serviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,148 @@
+package tfresource
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Retryable is a function that is used to decide if a function's error is retryable or not.
+type Retryable func(error) bool
+
+// States of the resource.StateChangeConf used by RetryWhenWithJitter.
+const (
+	retryStateRetryable = "retryable"
+	retryStateSuccess   = "success"
+)
+
+// RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
+// `f` is retried until `timeout` elapses.
+//
+// resource.Retry can time out before `f` has been called at all, for example
+// when the process is suspended or the timeout is very short. Only in that case is
+// one final attempt made, so that the timeout budget is not exceeded once `f`
+// has been given a chance to run.
+func RetryWhen(timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	var output interface{}
+	var attempted bool
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+
+		attempted = true
+		output, err = f()
+
+		if err == nil {
+			return nil
+		}
+
+		if retryable(err) {
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	if TimedOut(err) && !attempted {
+		output, err = f()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RetryWhenWithJitter retries the function `f` when the error it returns satisfies `retryable`,
+// like RetryWhen, but at a fixed interval chosen at random between `minInterval` and `maxInterval`.
+// Many resources retrying the same API call concurrently then do not retry in lockstep, which
+// would otherwise amplify request throttling.
+func RetryWhenWithJitter(timeout, minInterval, maxInterval time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	var output interface{}
+	var lastErr error
+	var attempted bool
+	var mu sync.Mutex
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{retryStateRetryable},
+		Target:       []string{retryStateSuccess},
+		Timeout:      timeout,
+		PollInterval: jitteredInterval(minInterval, maxInterval),
+		Refresh: func() (interface{}, string, error) {
+			o, err := f()
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			attempted = true
+			output, lastErr = o, err
+
+			if err == nil {
+				return struct{}{}, retryStateSuccess, nil
+			}
+
+			if retryable(err) {
+				return struct{}{}, retryStateRetryable, nil
+			}
+
+			return nil, "", err
+		},
+	}
+
+	_, err := stateConf.WaitForState()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if TimedOut(err) {
+		// As with resource.Retry, report the last error rather than the timeout.
+		if attempted {
+			err = lastErr
+		} else {
+			output, err = f()
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// jitteredInterval returns a random duration in [minInterval, maxInterval),
+// or minInterval if maxInterval is not greater than minInterval.
+func jitteredInterval(minInterval, maxInterval time.Duration) time.Duration {
+	if maxInterval <= minInterval {
+		return minInterval
+	}
+
+	return minInterval + time.Duration(rand.Int63n(int64(maxInterval-minInterval)))
+}
+
+// RetryableAwsErrCodeEquals returns a Retryable that is satisfied by AWS errors with one of the specified codes.
+func RetryableAwsErrCodeEquals(codes ...string) Retryable {
+	return func(err error) bool {
+		for _, code := range codes {
+			if tfawserr.ErrCodeEquals(err, code) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// RetryWhenAwsErrCodeEquals retries the function `f` when it returns an AWS error with one of the specified codes.
+func RetryWhenAwsErrCodeEquals(timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	return RetryWhen(timeout, f, RetryableAwsErrCodeEquals(codes...))
+}
+
+// RetryWhenNotFound retries the function `f` when it returns a "resource not found" error.
+func RetryWhenNotFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhen(timeout, f, NotFound)
+}
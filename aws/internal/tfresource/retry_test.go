@@ -0,0 +1,255 @@
+package tfresource_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestRetryWhenAwsErrCodeEquals(t *testing.T) {
+	var retryCount int
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("Testing", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if retryCount == 0 {
+					retryCount++
+
+					return nil, awserr.New("TestCode2", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		retryCount = 0
+
+		_, err := tfresource.RetryWhenAwsErrCodeEquals(5*time.Second, testCase.F, "TestCode1", "TestCode2")
+
+		if testCase.ExpectError && err == nil {
+			t.Fatalf("%s: expected error", testCase.Name)
+		} else if !testCase.ExpectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.Name, err)
+		}
+	}
+}
+
+func TestRetryWhenNotFound(t *testing.T) {
+	var retryCount int
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "not found error timeout",
+			F: func() (interface{}, error) {
+				return nil, &resource.NotFoundError{}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "not found error success",
+			F: func() (interface{}, error) {
+				if retryCount == 0 {
+					retryCount++
+
+					return nil, &resource.NotFoundError{}
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		retryCount = 0
+
+		_, err := tfresource.RetryWhenNotFound(5*time.Second, testCase.F)
+
+		if testCase.ExpectError && err == nil {
+			t.Fatalf("%s: expected error", testCase.Name)
+		} else if !testCase.ExpectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.Name, err)
+		}
+	}
+}
+
+func TestRetryWhenTimeoutBudget(t *testing.T) {
+	timeout := 2 * time.Second
+	start := time.Now()
+	var lastCall time.Time
+
+	_, err := tfresource.RetryWhen(timeout, func() (interface{}, error) {
+		lastCall = time.Now()
+
+		return nil, errors.New("retryable")
+	}, func(error) bool { return true })
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if lastCall.IsZero() {
+		t.Fatal("expected at least one call")
+	}
+
+	// No extra attempt may be made once the timeout has elapsed.
+	if elapsed := lastCall.Sub(start); elapsed >= timeout {
+		t.Errorf("last call made %s after start, expected before timeout (%s)", elapsed, timeout)
+	}
+}
+
+func TestRetryWhenWithJitter(t *testing.T) {
+	var retryCount int
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("Testing", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable error success",
+			F: func() (interface{}, error) {
+				if retryCount == 0 {
+					retryCount++
+
+					return nil, awserr.New("TestCode1", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		retryCount = 0
+
+		_, err := tfresource.RetryWhenWithJitter(2*time.Second, 100*time.Millisecond, 300*time.Millisecond, testCase.F, tfresource.RetryableAwsErrCodeEquals("TestCode1"))
+
+		if testCase.ExpectError && err == nil {
+			t.Fatalf("%s: expected error", testCase.Name)
+		} else if !testCase.ExpectError && err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.Name, err)
+		}
+	}
+}
+
+func TestRetryWhenWithJitterInterval(t *testing.T) {
+	minInterval := 200 * time.Millisecond
+	maxInterval := 400 * time.Millisecond
+	var calls []time.Time
+
+	_, err := tfresource.RetryWhenWithJitter(5*time.Second, minInterval, maxInterval, func() (interface{}, error) {
+		calls = append(calls, time.Now())
+
+		if len(calls) < 4 {
+			return nil, errors.New("retryable")
+		}
+
+		return nil, nil
+	}, func(error) bool { return true })
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var interval time.Duration
+	for i := 1; i < len(calls); i++ {
+		got := calls[i].Sub(calls[i-1])
+
+		if got < minInterval {
+			t.Errorf("retry %d made %s after the previous attempt, expected at least %s", i, got, minInterval)
+		}
+
+		// The interval is chosen once per call, so all retries are evenly spaced.
+		if interval == 0 {
+			interval = got
+		} else if diff := got - interval; diff > 100*time.Millisecond || diff < -100*time.Millisecond {
+			t.Errorf("retry %d made %s after the previous attempt, expected about %s", i, got, interval)
+		}
+	}
+}
+
+func TestRetryWhenWithJitterLastError(t *testing.T) {
+	_, err := tfresource.RetryWhenWithJitter(1*time.Second, 100*time.Millisecond, 200*time.Millisecond, func() (interface{}, error) {
+		return nil, awserr.New("TestCode1", "TestMessage", nil)
+	}, tfresource.RetryableAwsErrCodeEquals("TestCode1"))
+
+	if !tfawserr.ErrCodeEquals(err, "TestCode1") {
+		t.Errorf("expected last error to be returned, got: %v", err)
+	}
+}
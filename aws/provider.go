@@ -217,6 +217,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_snapshot_ids":                           dataSourceAwsEbsSnapshotIds(),
 			"aws_ebs_volume":                                 dataSourceAwsEbsVolume(),
 			"aws_ebs_volumes":                                dataSourceAwsEbsVolumes(),
+			"aws_ec2_carrier_gateway":                        dataSourceAwsEc2CarrierGateway(),
 			"aws_ec2_coip_pool":                              dataSourceAwsEc2CoipPool(),
 			"aws_ec2_coip_pools":                             dataSourceAwsEc2CoipPools(),
 			"aws_ec2_instance_type":                          dataSourceAwsEc2InstanceType(),
@@ -249,6 +250,7 @@ func Provider() *schema.Provider {
 			"aws_efs_access_points":                          dataSourceAwsEfsAccessPoints(),
 			"aws_efs_file_system":                            dataSourceAwsEfsFileSystem(),
 			"aws_efs_mount_target":                           dataSourceAwsEfsMountTarget(),
+			"aws_egress_only_internet_gateway":               dataSourceAwsEgressOnlyInternetGateway(),
 			"aws_eip":                                        dataSourceAwsEip(),
 			"aws_eks_cluster":                                dataSourceAwsEksCluster(),
 			"aws_eks_cluster_auth":                           dataSourceAwsEksClusterAuth(),
@@ -339,6 +341,7 @@ func Provider() *schema.Provider {
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
 			"aws_route_tables":                               dataSourceAwsRouteTables(),
+			"aws_routes":                                     dataSourceAwsRoutes(),
 			"aws_route53_delegation_set":                     dataSourceAwsDelegationSet(),
 			"aws_route53_resolver_endpoint":                  dataSourceAwsRoute53ResolverEndpoint(),
 			"aws_route53_resolver_rule":                      dataSourceAwsRoute53ResolverRule(),
@@ -367,6 +370,7 @@ func Provider() *schema.Provider {
 			"aws_storagegateway_local_disk":                  dataSourceAwsStorageGatewayLocalDisk(),
 			"aws_subnet":                                     dataSourceAwsSubnet(),
 			"aws_subnet_ids":                                 dataSourceAwsSubnetIDs(),
+			"aws_subnets":                                    dataSourceAwsSubnets(),
 			"aws_transfer_server":                            dataSourceAwsTransferServer(),
 			"aws_vpcs":                                       dataSourceAwsVpcs(),
 			"aws_security_group":                             dataSourceAwsSecurityGroup(),
@@ -767,6 +771,7 @@ func Provider() *schema.Provider {
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
 			"aws_kms_key":                                             resourceAwsKmsKey(),
+			"aws_kms_key_policy":                                      resourceAwsKmsKeyPolicy(),
 			"aws_kms_ciphertext":                                      resourceAwsKmsCiphertext(),
 			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
 			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
@@ -887,6 +892,7 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule_association":                   resourceAwsRoute53ResolverRuleAssociation(),
 			"aws_route53_resolver_rule":                               resourceAwsRoute53ResolverRule(),
 			"aws_route":                                               resourceAwsRoute(),
+			"aws_route_set":                                           resourceAwsRouteSet(),
 			"aws_route_table":                                         resourceAwsRouteTable(),
 			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
 			"aws_route_table_association":                             resourceAwsRouteTableAssociation(),
@@ -1013,11 +1019,14 @@ func Provider() *schema.Provider {
 			"aws_vpc":                                                 resourceAwsVpc(),
 			"aws_vpc_endpoint":                                        resourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_connection_notification":                resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                                 resourceAwsVpcEndpointPolicy(),
 			"aws_vpc_endpoint_route_table_association":                resourceAwsVpcEndpointRouteTableAssociation(),
 			"aws_vpc_endpoint_subnet_association":                     resourceAwsVpcEndpointSubnetAssociation(),
 			"aws_vpc_endpoint_service":                                resourceAwsVpcEndpointService(),
 			"aws_vpc_endpoint_service_allowed_principal":              resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_service_private_dns_verification":       resourceAwsVpcEndpointServicePrivateDnsVerification(),
 			"aws_vpc_ipv4_cidr_block_association":                     resourceAwsVpcIpv4CidrBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                     resourceAwsVpcIpv6CidrBlockAssociation(),
 			"aws_vpn_connection":                                      resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                                resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                                         resourceAwsVpnGateway(),
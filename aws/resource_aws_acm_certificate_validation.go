@@ -189,6 +189,7 @@ func resourceAwsAcmCertificateValidationRead(d *schema.ResourceData, meta interf
 		d.SetId("")
 	} else {
 		d.SetId(aws.TimeValue(resp.Certificate.IssuedAt).String())
+		d.Set("certificate_arn", resp.Certificate.CertificateArn)
 	}
 	return nil
 }
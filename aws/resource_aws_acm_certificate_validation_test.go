@@ -6,7 +6,10 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccAWSAcmCertificateValidation_basic(t *testing.T) {
@@ -25,12 +28,39 @@ func TestAccAWSAcmCertificateValidation_basic(t *testing.T) {
 				Config: testAccAcmCertificateValidation_basic(rootDomain, domain),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", certificateResourceName, "arn"),
+					testAccCheckAcmCertificateValidationIssued(resourceName),
 				),
 			},
 		},
 	})
 }
 
+// testAccCheckAcmCertificateValidationIssued checks that the certificate referenced by the validation's certificate_arn attribute has been issued.
+func testAccCheckAcmCertificateValidationIssued(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmconn
+
+		output, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(rs.Primary.Attributes["certificate_arn"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Certificate.Status); status != acm.CertificateStatusIssued {
+			return fmt.Errorf("ACM Certificate (%s) status is %s, expected %s", rs.Primary.Attributes["certificate_arn"], status, acm.CertificateStatusIssued)
+		}
+
+		return nil
+	}
+}
+
 func TestAccAWSAcmCertificateValidation_timeout(t *testing.T) {
 	rootDomain := testAccAwsAcmCertificateDomainFromEnv(t)
 	domain := testAccAwsAcmCertificateRandomSubDomain(rootDomain)
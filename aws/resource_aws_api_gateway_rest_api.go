@@ -76,6 +76,12 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"put_rest_api_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(apigateway.PutMode_Values(), false),
+			},
+
 			"minimum_compression_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -196,7 +202,7 @@ func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}
 
 		input := &apigateway.PutRestApiInput{
 			RestApiId: gateway.Id,
-			Mode:      aws.String(apigateway.PutModeOverwrite),
+			Mode:      aws.String(resourceAwsApiGatewayRestApiPutMode(d)),
 			Body:      []byte(body.(string)),
 		}
 
@@ -555,7 +561,7 @@ func resourceAwsApiGatewayRestApiUpdate(d *schema.ResourceData, meta interface{}
 
 			input := &apigateway.PutRestApiInput{
 				RestApiId: aws.String(d.Id()),
-				Mode:      aws.String(apigateway.PutModeOverwrite),
+				Mode:      aws.String(resourceAwsApiGatewayRestApiPutMode(d)),
 				Body:      []byte(body.(string)),
 			}
 
@@ -744,3 +750,13 @@ func flattenApiGatewayEndpointConfiguration(endpointConfiguration *apigateway.En
 
 	return []interface{}{m}
 }
+
+// resourceAwsApiGatewayRestApiPutMode returns the PutRestApi mode used to import the body,
+// defaulting to overwrite.
+func resourceAwsApiGatewayRestApiPutMode(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("put_rest_api_mode"); ok {
+		return v.(string)
+	}
+
+	return apigateway.PutModeOverwrite
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,6 +15,9 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 	dsubnet := resourceAwsSubnet()
 	dsubnet.Create = resourceAwsDefaultSubnetCreate
 	dsubnet.Delete = resourceAwsDefaultSubnetDelete
+	dsubnet.Importer = &schema.ResourceImporter{
+		State: resourceAwsDefaultSubnetImport,
+	}
 
 	// availability_zone is a required value for Default Subnets
 	dsubnet.Schema["availability_zone"] = &schema.Schema{
@@ -51,6 +55,17 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	// existing_default_subnet is whether the Default Subnet existed before Terraform managed it
+	dsubnet.Schema["existing_default_subnet"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	// force_destroy deletes the Default Subnet when the resource is destroyed
+	dsubnet.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dsubnet
 }
@@ -71,15 +86,54 @@ func resourceAwsDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if len(resp.Subnets) != 1 || resp.Subnets[0] == nil {
-		return fmt.Errorf("Default subnet not found")
+	if len(resp.Subnets) > 1 {
+		return fmt.Errorf("multiple Default Subnets found")
+	}
+
+	if len(resp.Subnets) == 1 && resp.Subnets[0] != nil {
+		d.SetId(aws.StringValue(resp.Subnets[0].SubnetId))
+		d.Set("existing_default_subnet", true)
+
+		return resourceAwsSubnetUpdate(d, meta)
+	}
+
+	log.Printf("[DEBUG] Default subnet not found, creating one")
+	output, err := conn.CreateDefaultSubnet(&ec2.CreateDefaultSubnetInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating default subnet: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Subnet.SubnetId))
+	d.Set("existing_default_subnet", false)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.SubnetStatePending},
+		Target:  []string{ec2.SubnetStateAvailable},
+		Refresh: SubnetStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for default subnet (%s) to become ready: %w", d.Id(), err)
 	}
 
-	d.SetId(aws.StringValue(resp.Subnets[0].SubnetId))
 	return resourceAwsSubnetUpdate(d, meta)
 }
 
 func resourceAwsDefaultSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy Default Subnet. Terraform will remove this resource from the state file, however resources may remain.")
-	return nil
+	if !d.Get("force_destroy").(bool) {
+		log.Printf("[WARN] Cannot destroy Default Subnet. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+
+	return resourceAwsSubnetDelete(d, meta)
+}
+
+func resourceAwsDefaultSubnetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("existing_default_subnet", true)
+	d.Set("force_destroy", false)
+
+	return []*schema.ResourceData{d}, nil
 }
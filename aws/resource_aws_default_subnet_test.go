@@ -37,6 +37,8 @@ func TestAccAWSDefaultSubnet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						resourceName, "tags.Name", fmt.Sprintf("terraform-testacc-default-subnet-%d", rInt)),
 					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "existing_default_subnet", "true"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
 				),
 			},
 		},
@@ -3,10 +3,15 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
 func resourceAwsDefaultVpc() *schema.Resource {
@@ -14,6 +19,12 @@ func resourceAwsDefaultVpc() *schema.Resource {
 	dvpc := resourceAwsVpc()
 	dvpc.Create = resourceAwsDefaultVpcCreate
 	dvpc.Delete = resourceAwsDefaultVpcDelete
+	dvpc.Importer = &schema.ResourceImporter{
+		State: resourceAwsDefaultVpcImport,
+	}
+	dvpc.Timeouts = &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(10 * time.Minute),
+	}
 
 	// cidr_block is a computed value for Default VPCs
 	dvpc.Schema["cidr_block"] = &schema.Schema{
@@ -30,6 +41,17 @@ func resourceAwsDefaultVpc() *schema.Resource {
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	// existing_default_vpc is whether the Default VPC existed before Terraform managed it
+	dvpc.Schema["existing_default_vpc"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	// force_destroy deletes the Default VPC when the resource is destroyed
+	dvpc.Schema["force_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
 
 	return dvpc
 }
@@ -50,16 +72,200 @@ func resourceAwsDefaultVpcCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if resp.Vpcs == nil || len(resp.Vpcs) == 0 {
-		return fmt.Errorf("No default VPC found in this region.")
+	if len(resp.Vpcs) > 0 && resp.Vpcs[0] != nil {
+		d.SetId(aws.StringValue(resp.Vpcs[0].VpcId))
+		d.Set("existing_default_vpc", true)
+
+		if a := vpcFirstAmazonProvidedIpv6CidrBlockAssociation(resp.Vpcs[0]); a != nil {
+			d.Set("ipv6_association_id", a.AssociationId)
+		}
+
+		return resourceAwsVpcUpdate(d, meta)
 	}
 
-	d.SetId(aws.StringValue(resp.Vpcs[0].VpcId))
+	log.Printf("[DEBUG] No default VPC found in this region, creating one")
+	output, err := conn.CreateDefaultVpc(&ec2.CreateDefaultVpcInput{})
+	if err != nil {
+		return fmt.Errorf("error creating default VPC: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Vpc.VpcId))
+	d.Set("existing_default_vpc", false)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VpcStatePending},
+		Target:  []string{ec2.VpcStateAvailable},
+		Refresh: VPCStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for default VPC (%s) to become available: %w", d.Id(), err)
+	}
 
 	return resourceAwsVpcUpdate(d, meta)
 }
 
 func resourceAwsDefaultVpcDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
+	if !d.Get("force_destroy").(bool) {
+		log.Printf("[WARN] Cannot destroy Default VPC. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+
+	// Check for resources that are not deleted along with the Default VPC before tearing anything down,
+	// so that a VPC that cannot be deleted is left intact. The same applies to an adopted Default VPC.
+	if err := resourceAwsDefaultVpcCheckDependencies(conn, d.Id()); err != nil {
+		return err
+	}
+
+	// The Default VPC's internet gateway and default subnets must be removed before the VPC.
+	// The main route table, default network ACL and default security group are deleted along with it.
+	igwResp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"attachment.vpc-id": d.Id(),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing default VPC (%s) Internet Gateways: %w", d.Id(), err)
+	}
+
+	for _, igw := range igwResp.InternetGateways {
+		if igw == nil {
+			continue
+		}
+
+		if err := resourceAwsDefaultVpcDeleteInternetGateway(conn, d.Id(), aws.StringValue(igw.InternetGatewayId)); err != nil {
+			return err
+		}
+	}
+
+	subnetResp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id":       d.Id(),
+			"defaultForAz": "true",
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing default VPC (%s) default subnets: %w", d.Id(), err)
+	}
+
+	for _, subnet := range subnetResp.Subnets {
+		if subnet == nil {
+			continue
+		}
+
+		subnetID := aws.StringValue(subnet.SubnetId)
+
+		log.Printf("[INFO] Deleting default subnet: %s", subnetID)
+		_, err := conn.DeleteSubnet(&ec2.DeleteSubnetInput{
+			SubnetId: aws.String(subnetID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidSubnetIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting default subnet (%s): %w", subnetID, err)
+		}
+	}
+
+	return resourceAwsVpcDelete(d, meta)
+}
+
+// resourceAwsDefaultVpcCheckDependencies returns an error listing the subnets other than default subnets,
+// the network interfaces and the VPC endpoints in the Default VPC, any of which prevent its deletion.
+func resourceAwsDefaultVpcCheckDependencies(conn *ec2.EC2, vpcID string) error {
+	var dependencies []string
+
+	subnetResp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id":       vpcID,
+			"defaultForAz": "false",
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing default VPC (%s) subnets: %w", vpcID, err)
+	}
+
+	for _, subnet := range subnetResp.Subnets {
+		if subnet != nil {
+			dependencies = append(dependencies, aws.StringValue(subnet.SubnetId))
+		}
+	}
+
+	eniResp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id": vpcID,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing default VPC (%s) network interfaces: %w", vpcID, err)
+	}
+
+	for _, eni := range eniResp.NetworkInterfaces {
+		if eni != nil {
+			dependencies = append(dependencies, aws.StringValue(eni.NetworkInterfaceId))
+		}
+	}
+
+	vpceResp, err := conn.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"vpc-id": vpcID,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing default VPC (%s) VPC endpoints: %w", vpcID, err)
+	}
+
+	for _, vpce := range vpceResp.VpcEndpoints {
+		if vpce != nil && aws.StringValue(vpce.State) != "deleted" {
+			dependencies = append(dependencies, aws.StringValue(vpce.VpcEndpointId))
+		}
+	}
+
+	if len(dependencies) > 0 {
+		return fmt.Errorf("default VPC (%s) cannot be deleted, it has dependencies that are not deleted with it: %s", vpcID, strings.Join(dependencies, ", "))
+	}
+
+	return nil
+}
+
+func resourceAwsDefaultVpcDeleteInternetGateway(conn *ec2.EC2, vpcID, igwID string) error {
+	log.Printf("[INFO] Detaching Internet Gateway (%s) from default VPC (%s)", igwID, vpcID)
+	_, err := conn.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+		InternetGatewayId: aws.String(igwID),
+		VpcId:             aws.String(vpcID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidInternetGatewayIDNotFound) {
+		return nil
+	}
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, tfec2.ErrCodeGatewayNotAttached) {
+		return fmt.Errorf("error detaching Internet Gateway (%s) from default VPC (%s): %w", igwID, vpcID, err)
+	}
+
+	log.Printf("[INFO] Deleting Internet Gateway: %s", igwID)
+	_, err = conn.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+		InternetGatewayId: aws.String(igwID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidInternetGatewayIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Internet Gateway (%s): %w", igwID, err)
+	}
+
 	return nil
 }
+
+func resourceAwsDefaultVpcImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("existing_default_vpc", true)
+	d.Set("force_destroy", false)
+
+	return resourceAwsVpcInstanceImport(d, meta)
+}
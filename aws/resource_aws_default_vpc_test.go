@@ -1,11 +1,16 @@
 package aws
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
 func TestAccAWSDefaultVpc_basic(t *testing.T) {
@@ -36,17 +41,144 @@ func TestAccAWSDefaultVpc_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"aws_default_vpc.foo", "ipv6_cidr_block", ""),
 					testAccCheckResourceAttrAccountID("aws_default_vpc.foo", "owner_id"),
+					resource.TestCheckResourceAttr(
+						"aws_default_vpc.foo", "existing_default_vpc", "true"),
+					resource.TestCheckResourceAttr(
+						"aws_default_vpc.foo", "force_destroy", "false"),
+				),
+			},
+		},
+	})
+}
+
+// The force_destroy tests delete the Default VPC of the account and region they run in,
+// so they are serial and only run when TF_ACC_DEFAULT_VPC_FORCE_DESTROY is set.
+func TestAccAWSDefaultVpc_ForceDestroy_ExistingDefaultVpc(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_default_vpc.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSDefaultVpcForceDestroy(t)
+			if !testAccHasDefaultVpc(t) {
+				t.Skip("skipping since the region has no Default VPC to adopt")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultVpcForceDestroyed(&vpc),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultVpcConfigForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "existing_default_vpc", "true"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDefaultVpc_ForceDestroy_PartiallyDeleted(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_default_vpc.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDefaultVpcForceDestroy(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultVpcForceDestroyed(&vpc),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultVpcConfigForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+					// Simulate a previous destroy that stopped after deleting the internet gateway and a default subnet.
+					testAccCheckAWSDefaultVpcPartiallyDelete(&vpc),
 				),
 			},
 		},
 	})
 }
 
+func testAccPreCheckAWSDefaultVpcForceDestroy(t *testing.T) {
+	if os.Getenv("TF_ACC_DEFAULT_VPC_FORCE_DESTROY") == "" {
+		t.Skip("TF_ACC_DEFAULT_VPC_FORCE_DESTROY env var must be set for Default VPC force_destroy acceptance tests, which delete the Default VPC.")
+	}
+}
+
 func testAccCheckAWSDefaultVpcDestroy(s *terraform.State) error {
 	// We expect VPC to still exist
 	return nil
 }
 
+func testAccCheckAWSDefaultVpcForceDestroyed(vpc *ec2.Vpc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+			VpcIds: []*string{vpc.VpcId},
+		})
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcIDNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Vpcs) > 0 {
+			return fmt.Errorf("Default VPC (%s) still exists", aws.StringValue(vpc.VpcId))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDefaultVpcPartiallyDelete(vpc *ec2.Vpc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		vpcID := aws.StringValue(vpc.VpcId)
+
+		igwResp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+			Filters: buildEC2AttributeFilterList(map[string]string{
+				"attachment.vpc-id": vpcID,
+			}),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, igw := range igwResp.InternetGateways {
+			if err := resourceAwsDefaultVpcDeleteInternetGateway(conn, vpcID, aws.StringValue(igw.InternetGatewayId)); err != nil {
+				return err
+			}
+		}
+
+		subnetResp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+			Filters: buildEC2AttributeFilterList(map[string]string{
+				"vpc-id":       vpcID,
+				"defaultForAz": "true",
+			}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(subnetResp.Subnets) == 0 {
+			return fmt.Errorf("Default VPC (%s) has no default subnets", vpcID)
+		}
+
+		_, err = conn.DeleteSubnet(&ec2.DeleteSubnetInput{
+			SubnetId: subnetResp.Subnets[0].SubnetId,
+		})
+
+		return err
+	}
+}
+
 const testAccAWSDefaultVpcConfigBasic = `
 resource "aws_default_vpc" "foo" {
   tags = {
@@ -54,3 +186,13 @@ resource "aws_default_vpc" "foo" {
   }
 }
 `
+
+const testAccAWSDefaultVpcConfigForceDestroy = `
+resource "aws_default_vpc" "foo" {
+  force_destroy = true
+
+  tags = {
+    Name = "Default VPC"
+  }
+}
+`
@@ -17,15 +17,22 @@ func resourceAwsEc2AvailabilityZoneGroup() *schema.Resource {
 		Create: resourceAwsEc2AvailabilityZoneGroupCreate,
 		Read:   resourceAwsEc2AvailabilityZoneGroupRead,
 		Update: resourceAwsEc2AvailabilityZoneGroupUpdate,
-		Delete: schema.Noop,
+		Delete: resourceAwsEc2AvailabilityZoneGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				d.Set("group_name", d.Id())
+				d.Set("opt_out_on_destroy", false)
 
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:     schema.TypeString,
@@ -40,6 +47,11 @@ func resourceAwsEc2AvailabilityZoneGroup() *schema.Resource {
 					ec2.AvailabilityZoneOptInStatusNotOptedIn,
 				}, false),
 			},
+			"opt_out_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -67,7 +79,7 @@ func resourceAwsEc2AvailabilityZoneGroupCreate(d *schema.ResourceData, meta inte
 			return fmt.Errorf("error modifying EC2 Availability Zone Group (%s): %w", d.Id(), err)
 		}
 
-		if err := waitForEc2AvailabilityZoneGroupOptInStatus(conn, d.Id(), configurationOptInStatus); err != nil {
+		if err := waitForEc2AvailabilityZoneGroupOptInStatus(conn, d.Id(), configurationOptInStatus, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting for EC2 Availability Zone Group (%s) opt-in status update: %w", d.Id(), err)
 		}
 	}
@@ -107,13 +119,47 @@ func resourceAwsEc2AvailabilityZoneGroupUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error modifying EC2 Availability Zone Group (%s): %w", d.Id(), err)
 	}
 
-	if err := waitForEc2AvailabilityZoneGroupOptInStatus(conn, d.Id(), optInStatus); err != nil {
+	if err := waitForEc2AvailabilityZoneGroupOptInStatus(conn, d.Id(), optInStatus, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("error waiting for EC2 Availability Zone Group (%s) opt-in status update: %w", d.Id(), err)
 	}
 
 	return resourceAwsEc2AvailabilityZoneGroupRead(d, meta)
 }
 
+func resourceAwsEc2AvailabilityZoneGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Opting out has side effects on resources in the zones, so it is only done on request.
+	if !d.Get("opt_out_on_destroy").(bool) {
+		return nil
+	}
+
+	availabilityZone, err := ec2DescribeAvailabilityZoneGroup(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Availability Zone Group (%s): %w", d.Id(), err)
+	}
+
+	if availabilityZone == nil || aws.StringValue(availabilityZone.OptInStatus) != ec2.AvailabilityZoneOptInStatusOptedIn {
+		return nil
+	}
+
+	input := &ec2.ModifyAvailabilityZoneGroupInput{
+		GroupName:   aws.String(d.Id()),
+		OptInStatus: aws.String(ec2.AvailabilityZoneOptInStatusNotOptedIn),
+	}
+
+	if _, err := conn.ModifyAvailabilityZoneGroup(input); err != nil {
+		return fmt.Errorf("error modifying EC2 Availability Zone Group (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForEc2AvailabilityZoneGroupOptInStatus(conn, d.Id(), ec2.AvailabilityZoneOptInStatusNotOptedIn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Availability Zone Group (%s) opt-in status update: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
 func ec2DescribeAvailabilityZoneGroup(conn *ec2.EC2, groupName string) (*ec2.AvailabilityZone, error) {
 	input := &ec2.DescribeAvailabilityZonesInput{
 		AllAvailabilityZones: aws.Bool(true),
@@ -164,7 +210,7 @@ func ec2AvailabilityZoneGroupOptInStatusRefreshFunc(conn *ec2.EC2, groupName str
 	}
 }
 
-func waitForEc2AvailabilityZoneGroupOptInStatus(conn *ec2.EC2, groupName string, optInStatus string) error {
+func waitForEc2AvailabilityZoneGroupOptInStatus(conn *ec2.EC2, groupName string, optInStatus string, timeout time.Duration) error {
 	pending := ec2.AvailabilityZoneOptInStatusNotOptedIn
 
 	if optInStatus == ec2.AvailabilityZoneOptInStatusNotOptedIn {
@@ -175,7 +221,7 @@ func waitForEc2AvailabilityZoneGroupOptInStatus(conn *ec2.EC2, groupName string,
 		Pending:                   []string{pending},
 		Target:                    []string{optInStatus},
 		Refresh:                   ec2AvailabilityZoneGroupOptInStatusRefreshFunc(conn, groupName),
-		Timeout:                   10 * time.Minute,
+		Timeout:                   timeout,
 		Delay:                     10 * time.Second,
 		MinTimeout:                2 * time.Second,
 		ContinuousTargetOccurence: 3,
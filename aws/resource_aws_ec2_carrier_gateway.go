@@ -58,6 +58,10 @@ func resourceAwsEc2CarrierGatewayCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Creating EC2 Carrier Gateway: %s", input)
 	output, err := conn.CreateCarrierGateway(input)
 
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeUnsupportedOperation) {
+		return fmt.Errorf("error creating EC2 Carrier Gateway: Carrier Gateways require an opted-in Wavelength Zone in the current region (see the aws_ec2_availability_zone_group resource): %w", err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating EC2 Carrier Gateway: %w", err)
 	}
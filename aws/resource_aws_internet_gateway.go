@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +25,11 @@ func resourceAwsInternetGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -106,12 +112,10 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	ig := igRaw.(*ec2.InternetGateway)
-	if len(ig.Attachments) == 0 {
-		// Gateway exists but not attached to the VPC
-		d.Set("vpc_id", "")
-	} else {
-		d.Set("vpc_id", ig.Attachments[0].VpcId)
-	}
+
+	// Only report attachments which are in effect so that a gateway
+	// detached outside of Terraform shows a difference on vpc_id.
+	d.Set("vpc_id", internetGatewayAttachedVpcID(ig))
 
 	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(ig.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
@@ -135,7 +139,7 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("vpc_id") {
 		// If we're already attached, detach it first
-		if err := resourceAwsInternetGatewayDetach(d, meta); err != nil {
+		if err := resourceAwsInternetGatewayDetach(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 
@@ -161,8 +165,11 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 func resourceAwsInternetGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	// Detaching and deleting share the delete timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+
 	// Detach if it is attached
-	if err := resourceAwsInternetGatewayDetach(d, meta); err != nil {
+	if err := resourceAwsInternetGatewayDetach(d, meta, time.Until(deadline)); err != nil {
 		return err
 	}
 
@@ -170,7 +177,7 @@ func resourceAwsInternetGatewayDelete(d *schema.ResourceData, meta interface{})
 	input := &ec2.DeleteInternetGatewayInput{
 		InternetGatewayId: aws.String(d.Id()),
 	}
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
 		_, err := conn.DeleteInternetGateway(input)
 		if err == nil {
 			return nil
@@ -253,7 +260,7 @@ func resourceAwsInternetGatewayAttach(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAwsInternetGatewayDetach(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsInternetGatewayDetach(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	// Get the old VPC ID to detach from
@@ -277,19 +284,63 @@ func resourceAwsInternetGatewayDetach(d *schema.ResourceData, meta interface{})
 		Pending:        []string{ec2.AttachmentStatusDetaching},
 		Target:         []string{ec2.AttachmentStatusDetached},
 		Refresh:        detachIGStateRefreshFunc(conn, d.Id(), vpcID.(string)),
-		Timeout:        15 * time.Minute,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		NotFoundChecks: 30,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf(
-			"Error waiting for internet gateway (%s) to detach: %s",
-			d.Id(), err)
+		return fmt.Errorf("error detaching EC2 Internet Gateway (%s) from VPC (%s): %w%s",
+			d.Id(), vpcID.(string), err, internetGatewayDetachPublicAddressesHint(conn, vpcID.(string)))
 	}
 
 	return nil
 }
 
+// internetGatewayAttachedVpcID returns the ID of the VPC the internet gateway is attached to,
+// ignoring attachments that are detached or being detached. An empty string is returned if there is none.
+func internetGatewayAttachedVpcID(ig *ec2.InternetGateway) string {
+	for _, attachment := range ig.Attachments {
+		if attachment == nil {
+			continue
+		}
+
+		switch aws.StringValue(attachment.State) {
+		case ec2.AttachmentStatusDetached, ec2.AttachmentStatusDetaching:
+			continue
+		}
+
+		return aws.StringValue(attachment.VpcId)
+	}
+
+	return ""
+}
+
+// internetGatewayDetachPublicAddressesHint returns a description of the
+// network interfaces that still have public addresses mapped in the VPC,
+// which cause DependencyViolation errors when detaching an internet gateway.
+func internetGatewayDetachPublicAddressesHint(conn *ec2.EC2, vpcID string) string {
+	out, err := findPublicNetworkInterfacesForVpcID(conn, vpcID)
+
+	if err != nil || out == nil || len(out.NetworkInterfaces) == 0 {
+		return ""
+	}
+
+	var addresses []string
+	for _, networkInterface := range out.NetworkInterfaces {
+		if networkInterface == nil || networkInterface.Association == nil {
+			continue
+		}
+
+		addresses = append(addresses, fmt.Sprintf("%s (%s)", aws.StringValue(networkInterface.Association.PublicIp), aws.StringValue(networkInterface.NetworkInterfaceId)))
+	}
+
+	if len(addresses) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\nThe VPC still has public addresses mapped, which must be released or disassociated before the internet gateway can be detached: %s", strings.Join(addresses, ", "))
+}
+
 // InstanceStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an EC2 instance.
 func detachIGStateRefreshFunc(conn *ec2.EC2, gatewayID, vpcID string) resource.StateRefreshFunc {
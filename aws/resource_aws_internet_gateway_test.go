@@ -119,6 +119,52 @@ func testSweepInternetGateways(region string) error {
 	return nil
 }
 
+func TestInternetGatewayAttachedVpcID(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Attachments []*ec2.InternetGatewayAttachment
+		Expected    string
+	}{
+		{
+			Name:     "no attachments",
+			Expected: "",
+		},
+		{
+			Name: "available",
+			Attachments: []*ec2.InternetGatewayAttachment{
+				{State: aws.String("available"), VpcId: aws.String("vpc-12345678")},
+			},
+			Expected: "vpc-12345678",
+		},
+		{
+			Name: "detaching",
+			Attachments: []*ec2.InternetGatewayAttachment{
+				{State: aws.String(ec2.AttachmentStatusDetaching), VpcId: aws.String("vpc-12345678")},
+			},
+			Expected: "",
+		},
+		{
+			Name: "detached then attaching",
+			Attachments: []*ec2.InternetGatewayAttachment{
+				nil,
+				{State: aws.String(ec2.AttachmentStatusDetached), VpcId: aws.String("vpc-12345678")},
+				{State: aws.String(ec2.AttachmentStatusAttaching), VpcId: aws.String("vpc-87654321")},
+			},
+			Expected: "vpc-87654321",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := internetGatewayAttachedVpcID(&ec2.InternetGateway{Attachments: testCase.Attachments})
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccAWSInternetGateway_basic(t *testing.T) {
 	var v, v2 ec2.InternetGateway
 	resourceName := "aws_internet_gateway.test"
@@ -271,6 +317,34 @@ func TestAccAWSInternetGateway_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAWSInternetGateway_manualDetach(t *testing.T) {
+	var ig ec2.InternetGateway
+	resourceName := "aws_internet_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInternetGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInternetGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInternetGatewayExists(resourceName, &ig),
+					testAccCheckInternetGatewayDetach(&ig),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccInternetGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInternetGatewayExists(resourceName, &ig),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInternetGatewayDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -328,6 +402,23 @@ func testAccCheckInternetGatewayExists(n string, ig *ec2.InternetGateway) resour
 	}
 }
 
+func testAccCheckInternetGatewayDetach(ig *ec2.InternetGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		if len(ig.Attachments) == 0 {
+			return fmt.Errorf("Internet Gateway (%s) is not attached", aws.StringValue(ig.InternetGatewayId))
+		}
+
+		_, err := conn.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+			InternetGatewayId: ig.InternetGatewayId,
+			VpcId:             ig.Attachments[0].VpcId,
+		})
+
+		return err
+	}
+}
+
 const testAccNoInternetGatewayConfig = `
 resource "aws_vpc" "test" {
   cidr_block = "10.1.0.0/16"
@@ -272,7 +272,7 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	if d.HasChange("policy") {
-		if err := resourceAwsKmsKeyPolicyUpdate(conn, d); err != nil {
+		if err := resourceAwsKmsKeyUpdatePolicy(conn, d); err != nil {
 			return err
 		}
 	}
@@ -311,7 +311,7 @@ func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, d *schema.ResourceData) e
 	return err
 }
 
-func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, d *schema.ResourceData) error {
+func resourceAwsKmsKeyUpdatePolicy(conn *kms.KMS, d *schema.ResourceData) error {
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 	if err != nil {
 		return fmt.Errorf("policy contains an invalid JSON: %s", err)
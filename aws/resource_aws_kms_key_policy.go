@@ -0,0 +1,324 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	awspolicy "github.com/jen20/awspolicyequivalence"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+const kmsKeyPolicyNameDefault = "default"
+
+func resourceAwsKmsKeyPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsKmsKeyPolicyCreate,
+		ReadContext:   resourceAwsKmsKeyPolicyRead,
+		UpdateContext: resourceAwsKmsKeyPolicyUpdate,
+		DeleteContext: resourceAwsKmsKeyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsKeyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).kmsconn
+
+	keyID := d.Get("key_id").(string)
+
+	// The key may have just been created.
+	outputRaw, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+			KeyId: aws.String(keyID),
+		})
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing KMS Key (%s): %s", keyID, err)
+	}
+
+	diags := kmsKeyPolicyConflictDiagnostics(ctx, conn, keyID, outputRaw.(*kms.DescribeKeyOutput).KeyMetadata)
+
+	input, err := kmsKeyPolicyPutInput(keyID, d.Get("policy").(string))
+
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	log.Printf("[DEBUG] Putting KMS Key policy: %s", input)
+
+	// Principals referenced in the policy may have just been created and are subject to IAM eventual consistency.
+	err = resource.RetryContext(ctx, iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.PutKeyPolicyWithContext(ctx, input)
+
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutKeyPolicyWithContext(ctx, input)
+	}
+
+	if err != nil {
+		return append(diags, diag.Errorf("error creating KMS Key (%s) policy: %s", keyID, err)...)
+	}
+
+	d.SetId(keyID)
+
+	return append(diags, resourceAwsKmsKeyPolicyRead(ctx, d, meta)...)
+}
+
+// kmsKeyPolicyConflictDiagnostics returns a warning if the key's policy is not the default key policy before
+// it is replaced. This is the case when the policy is also managed by the policy argument of aws_kms_key,
+// which then overwrites this resource's policy on its next update.
+func kmsKeyPolicyConflictDiagnostics(ctx context.Context, conn *kms.KMS, keyID string, keyMetadata *kms.KeyMetadata) diag.Diagnostics {
+	defaultPolicy, err := kmsKeyPolicyDefaultForKey(keyMetadata)
+
+	if err != nil {
+		return nil
+	}
+
+	output, err := conn.GetKeyPolicyWithContext(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(keyID),
+		PolicyName: aws.String(kmsKeyPolicyNameDefault),
+	})
+
+	if err != nil || output == nil {
+		return nil
+	}
+
+	if equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(output.Policy), defaultPolicy); err != nil || equivalent {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("KMS Key (%s) already has a key policy other than the default key policy", keyID),
+			Detail:   "The existing key policy is replaced. If it is managed by the policy argument of an aws_kms_key resource, remove that argument, otherwise the two resources will overwrite each other's policy.",
+		},
+	}
+}
+
+func resourceAwsKmsKeyPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	}
+
+	var output *kms.DescribeKeyOutput
+	var err error
+
+	if d.IsNewResource() {
+		var outputRaw interface{}
+		outputRaw, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKeyWithContext(ctx, input)
+		})
+		output, _ = outputRaw.(*kms.DescribeKeyOutput)
+	} else {
+		output, err = conn.DescribeKeyWithContext(ctx, input)
+	}
+
+	if !d.IsNewResource() && isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] KMS Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error describing KMS Key (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.KeyMetadata == nil {
+		return diag.Errorf("error describing KMS Key (%s): empty response", d.Id())
+	}
+
+	if aws.StringValue(output.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+		log.Printf("[WARN] KMS Key (%s) is pending deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policyOutput, err := conn.GetKeyPolicyWithContext(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(d.Id()),
+		PolicyName: aws.String(kmsKeyPolicyNameDefault),
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading KMS Key (%s) policy: %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(policyOutput.Policy))
+
+	if err != nil {
+		return diag.Errorf("policy contains an invalid JSON: %s", err)
+	}
+
+	d.Set("key_id", d.Id())
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsKmsKeyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).kmsconn
+
+	if d.HasChange("policy") {
+		input, err := kmsKeyPolicyPutInput(d.Id(), d.Get("policy").(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] Putting KMS Key policy: %s", input)
+
+		if _, err := conn.PutKeyPolicyWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating KMS Key (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKmsKeyPolicyRead(ctx, d, meta)
+}
+
+func resourceAwsKmsKeyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).kmsconn
+
+	output, err := conn.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error describing KMS Key (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.KeyMetadata == nil {
+		return diag.Errorf("error describing KMS Key (%s): empty response", d.Id())
+	}
+
+	// The key may already be scheduled for deletion when both resources are destroyed together.
+	if aws.StringValue(output.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+		return nil
+	}
+
+	// A key always has a policy, so "deleting" it means restoring the
+	// policy KMS attaches to keys created without an explicit one.
+	// The key may be owned by another account than the caller's.
+	policy, err := kmsKeyPolicyDefaultForKey(output.KeyMetadata)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, err := kmsKeyPolicyPutInput(d.Id(), policy)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Putting KMS Key policy: %s", input)
+	_, err = conn.PutKeyPolicyWithContext(ctx, input)
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	// The key may have been scheduled for deletion since it was described.
+	if isAWSErr(err, kms.ErrCodeInvalidStateException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error resetting KMS Key (%s) policy to default: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// kmsKeyPolicyPutInput returns the PutKeyPolicy input that sets the key's default policy to the specified policy.
+func kmsKeyPolicyPutInput(keyID string, policy string) (*kms.PutKeyPolicyInput, error) {
+	policy, err := structure.NormalizeJsonString(policy)
+
+	if err != nil {
+		return nil, fmt.Errorf("policy contains an invalid JSON: %w", err)
+	}
+
+	input := &kms.PutKeyPolicyInput{
+		KeyId:      aws.String(keyID),
+		Policy:     aws.String(policy),
+		PolicyName: aws.String(kmsKeyPolicyNameDefault),
+	}
+
+	return input, nil
+}
+
+// kmsKeyPolicyDefaultForKey returns the default key policy for the key, granting access to the account that owns it.
+func kmsKeyPolicyDefaultForKey(keyMetadata *kms.KeyMetadata) (string, error) {
+	if keyMetadata == nil {
+		return "", fmt.Errorf("empty KMS Key metadata")
+	}
+
+	keyARN, err := arn.Parse(aws.StringValue(keyMetadata.Arn))
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing KMS Key (%s) ARN: %w", aws.StringValue(keyMetadata.KeyId), err)
+	}
+
+	return kmsKeyPolicyDefault(keyARN.Partition, aws.StringValue(keyMetadata.AWSAccountId)), nil
+}
+
+// kmsKeyPolicyDefault returns the key policy KMS applies to keys created without a policy.
+// Reference: https://docs.aws.amazon.com/kms/latest/developerguide/key-policies.html#key-policy-default
+func kmsKeyPolicyDefault(partition, accountID string) string {
+	return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "arn:%[1]s:iam::%[2]s:root"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`, partition, accountID)
+}
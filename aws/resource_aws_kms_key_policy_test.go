@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestKmsKeyPolicyDefaultForKey(t *testing.T) {
+	// The policy grants access to the account that owns the key, which may not be the caller's.
+	policy, err := kmsKeyPolicyDefaultForKey(&kms.KeyMetadata{
+		AWSAccountId: aws.String("111122223333"),
+		Arn:          aws.String("arn:aws-us-gov:kms:us-gov-west-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		KeyId:        aws.String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := kmsKeyPolicyDefault("aws-us-gov", "111122223333"); policy != expected {
+		t.Errorf("got %s, expected %s", policy, expected)
+	}
+
+	if _, err := kmsKeyPolicyDefaultForKey(&kms.KeyMetadata{Arn: aws.String("invalid")}); err == nil {
+		t.Error("expected error for invalid ARN, got none")
+	}
+}
+
+func TestAccAWSKmsKeyPolicy_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_key_policy.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsKeyPolicyConfig(rName, "kms:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(keyResourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"kms:\*"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKmsKeyPolicyConfig(rName, "kms:Describe*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(keyResourceName, &key),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"kms:Describe\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsKeyPolicy_disappears_Key(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsKeyPolicyConfig(rName, "kms:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(keyResourceName, &key),
+					testAccCheckAWSKmsKeyDisappears(&key),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSKmsKeyPolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key_policy" "test" {
+  key_id = aws_kms_key.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Id      = %[1]q
+    Statement = [
+      {
+        Sid    = "Enable IAM User Permissions"
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Action   = "kms:*"
+        Resource = "*"
+      },
+      {
+        Sid    = "Test"
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Action   = %[2]q
+        Resource = "*"
+      },
+    ]
+  })
+}
+`, rName, action)
+}
@@ -1,21 +1,79 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
-// How long to sleep if a limit-exceeded event happens
+// routeStatePending is reported while an instance-targeted route has no network interface yet.
+const routeStatePending = "pending"
+
+// Bounds of the randomized interval between retries of route API calls, so that many routes
+// created or deleted concurrently do not retry in lockstep and trigger RequestLimitExceeded.
+const (
+	routeRetryMinInterval = 1 * time.Second
+	routeRetryMaxInterval = 5 * time.Second
+)
+
+// Address families of route destinations.
+const (
+	routeAddressFamilyIpv4 = "IPv4"
+	routeAddressFamilyIpv6 = "IPv6"
+)
+
+// routeTargetAddressFamilies maps each route target argument to the destination address families it supports.
+var routeTargetAddressFamilies = map[string][]string{
+	"egress_only_gateway_id":    {routeAddressFamilyIpv6},
+	"gateway_id":                {routeAddressFamilyIpv4, routeAddressFamilyIpv6},
+	"instance_id":               {routeAddressFamilyIpv4, routeAddressFamilyIpv6},
+	"local_gateway_id":          {routeAddressFamilyIpv4},
+	"nat_gateway_id":            {routeAddressFamilyIpv4},
+	"network_interface_id":      {routeAddressFamilyIpv4, routeAddressFamilyIpv6},
+	"transit_gateway_id":        {routeAddressFamilyIpv4, routeAddressFamilyIpv6},
+	"vpc_endpoint_id":           {routeAddressFamilyIpv4, routeAddressFamilyIpv6},
+	"vpc_peering_connection_id": {routeAddressFamilyIpv4, routeAddressFamilyIpv6},
+}
+
+// routeTargets returns the route target arguments in a stable order.
+func routeTargets() []string {
+	targets := make([]string, 0, len(routeTargetAddressFamilies))
+
+	for target := range routeTargetAddressFamilies {
+		targets = append(targets, target)
+	}
+
+	sort.Strings(targets)
+
+	return targets
+}
+
+// routeTagKeyPrefix prefixes the route table tags that hold the description and tags of the table's routes.
+// The tags of a route are keyed by the route's ID, so that they can be told apart from the route table's own tags.
+const routeTagKeyPrefix = "terraform:aws_route:"
+
+// routeTargetValidationError is returned when more than one route target is configured.
 var routeTargetValidationError = errors.New("Error: more than 1 target specified. Only 1 of gateway_id, " +
 	"egress_only_gateway_id, nat_gateway_id, instance_id, network_interface_id, local_gateway_id, transit_gateway_id, " +
 	"vpc_endpoint_id, vpc_peering_connection_id is allowed.")
@@ -23,39 +81,77 @@ var routeTargetValidationError = errors.New("Error: more than 1 target specified
 // AWS Route resource Schema declaration
 func resourceAwsRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRouteCreate,
-		Read:   resourceAwsRouteRead,
-		Update: resourceAwsRouteUpdate,
-		Delete: resourceAwsRouteDelete,
+		CreateContext: resourceAwsRouteCreateContext,
+		Read:          resourceAwsRouteRead,
+		UpdateContext: resourceAwsRouteUpdateContext,
+		Delete:        resourceAwsRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "_")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION", d.Id())
+				routeTableID, destination, err := resourceAwsRouteParseImportID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+
+				if _, errs := validateRouteTableID(routeTableID, "route_table_id"); len(errs) > 0 {
+					return nil, errs[0]
+				}
+
+				if err := resourceAwsRouteValidateNotPropagated(meta.(*AWSClient).ec2conn, routeTableID, destination); err != nil {
+					return nil, err
 				}
-				routeTableID := idParts[0]
-				destination := idParts[1]
 				d.Set("route_table_id", routeTableID)
-				if strings.Contains(destination, ":") {
+				switch {
+				case strings.HasPrefix(destination, "pl-"):
+					d.Set("destination_prefix_list_id", destination)
+				case strings.Contains(destination, ":"):
 					d.Set("destination_ipv6_cidr_block", destination)
-				} else {
+				default:
 					d.Set("destination_cidr_block", destination)
 				}
+				d.Set("warn_on_overlapping_routes", false)
+				d.Set("allow_destination_change_in_place", false)
+				d.Set("adopt_existing", false)
+				d.Set("validate_instance_state", false)
 				d.SetId(fmt.Sprintf("r-%s%d", routeTableID, hashcode.String(destination)))
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(2 * time.Minute),
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsRouteCustomizeDiffDestinationChange,
+			resourceAwsRouteCustomizeDiffTarget,
+			resourceAwsRouteCustomizeDiffTargetAddressFamily,
+		),
+
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_destination_change_in_place": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+
+			// Changing the destination forces a new resource unless allow_destination_change_in_place is set.
 			"destination_cidr_block": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				ValidateFunc: validation.Any(
 					validation.StringIsEmpty,
 					validateIpv4CIDRNetworkAddress,
@@ -65,7 +161,6 @@ func resourceAwsRoute() *schema.Resource {
 			"destination_ipv6_cidr_block": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				ValidateFunc: validation.Any(
 					validation.StringIsEmpty,
 					validateIpv6CIDRNetworkAddress,
@@ -78,6 +173,16 @@ func resourceAwsRoute() *schema.Resource {
 				Computed: true,
 			},
 
+			"destination_is_prefix_list": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"destination_managed_by_vpc_endpoint": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"gateway_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -124,20 +229,56 @@ func resourceAwsRoute() *schema.Resource {
 				Computed: true,
 			},
 
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"poll_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRoutePollInterval,
+			},
+
+			"propagated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"tags": tagsSchema(),
+
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRouteTableID,
 			},
 
 			"transit_gateway_id": {
-				Type:     schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEc2TransitGatewayIDOrARN,
+				// Store the identifier when the transit gateway is specified by ARN.
+				StateFunc: func(v interface{}) string {
+					id, err := tfec2.TransitGatewayIDFromARNOrID(v.(string))
+
+					if err != nil {
+						return v.(string)
+					}
+
+					return id
+				},
+			},
+
+			"validate_instance_state": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 
 			"vpc_endpoint_id": {
@@ -149,25 +290,33 @@ func resourceAwsRoute() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"warn_on_overlapping_routes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+// resourceAwsRouteCreateContext creates the route, warning when the route table is owned by another account
+// and about overlapping routes when warn_on_overlapping_routes is set.
+func resourceAwsRouteCreateContext(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceAwsRouteCreate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := routeTableOwnerDiagnostics(d.Get("route_table_id").(string), d.Get("owner_id").(string), meta.(*AWSClient).accountid)
+
+	return append(diags, resourceAwsRouteOverlappingRoutesDiagnostics(d, meta)...)
+}
+
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	var numTargets int
 	var setTarget string
-	allowedTargets := []string{
-		"egress_only_gateway_id",
-		"gateway_id",
-		"nat_gateway_id",
-		"local_gateway_id",
-		"instance_id",
-		"network_interface_id",
-		"transit_gateway_id",
-		"vpc_peering_connection_id",
-		"vpc_endpoint_id",
-	}
+	allowedTargets := routeTargets()
 
 	// Check if more than 1 target is specified
 	for _, target := range allowedTargets {
@@ -245,9 +394,15 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 	case "transit_gateway_id":
+		transitGatewayID, err := tfec2.TransitGatewayIDFromARNOrID(d.Get("transit_gateway_id").(string))
+
+		if err != nil {
+			return err
+		}
+
 		createOpts = &ec2.CreateRouteInput{
 			RouteTableId:     aws.String(d.Get("route_table_id").(string)),
-			TransitGatewayId: aws.String(d.Get("transit_gateway_id").(string)),
+			TransitGatewayId: aws.String(transitGatewayID),
 		}
 
 		if v, ok := d.GetOk("destination_cidr_block"); ok {
@@ -291,98 +446,119 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Route create config: %s", createOpts)
 
-	// Create the route
-	var err error
-
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err = conn.CreateRoute(createOpts)
-
-		if isAWSErr(err, "InvalidParameterException", "") {
-			return resource.RetryableError(err)
+	if setTarget == "instance_id" && d.Get("validate_instance_state").(bool) {
+		if err := resourceAwsRouteValidateInstanceState(conn, d.Get("instance_id").(string)); err != nil {
+			return fmt.Errorf("Error creating route: %w", err)
 		}
+	}
 
-		if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
-			return resource.RetryableError(err)
-		}
+	minInterval, maxInterval := resourceAwsRoutePollIntervals(d)
 
-		if err != nil {
-			return resource.NonRetryableError(err)
+	// Create the route
+	_, err := tfresource.RetryWhenWithJitter(d.Timeout(schema.TimeoutCreate), minInterval, maxInterval, func() (interface{}, error) {
+		return conn.CreateRoute(createOpts)
+	}, tfresource.RetryableAwsErrCodeEquals("InvalidParameterException", "InvalidTransitGatewayID.NotFound"))
+
+	// A route left behind by a partial apply or created by another tool may already
+	// point at the configured target, in which case it is adopted as is when opted in.
+	// Any other existing route is an error: it may be managed by another resource.
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeRouteAlreadyExists) && d.Get("adopt_existing").(bool) {
+		route, _, findErr := resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string), d.Get("destination_ipv6_cidr_block").(string), "")
+
+		if findErr == nil && aws.StringValue(route.Origin) != ec2.RouteOriginEnableVgwRoutePropagation && resourceAwsRouteTargetMatches(d, route) {
+			log.Printf("[INFO] Route already exists with the same target, adopting it: %s", createOpts)
+			err = nil
 		}
-
-		return nil
-	})
-	if isResourceTimeoutError(err) {
-		_, err = conn.CreateRoute(createOpts)
 	}
+
 	if err != nil {
 		return fmt.Errorf("Error creating route: %s", err)
 	}
 
-	var route *ec2.Route
+	outputRaw, err := tfresource.RetryWhenWithJitter(d.Timeout(schema.TimeoutCreate), minInterval, maxInterval, func() (interface{}, error) {
+		route, _, err := resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string), d.Get("destination_ipv6_cidr_block").(string), "")
 
-	if v, ok := d.GetOk("destination_cidr_block"); ok {
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), v.(string), "")
-			if err == nil {
-				if route != nil {
-					return nil
-				} else {
-					err = errors.New("Route not found")
-				}
-			}
+		return route, err
+	}, tfresource.NotFound)
 
-			return resource.RetryableError(err)
-		})
-		if isResourceTimeoutError(err) {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), v.(string), "")
-		}
-		if err != nil {
-			return fmt.Errorf("Error finding route after creating it: %s", err)
-		}
-		if route == nil {
-			return fmt.Errorf("Unable to find matching route for Route Table (%s) and destination CIDR block (%s).", d.Get("route_table_id").(string), v)
-		}
+	if err != nil {
+		return fmt.Errorf("Error finding route after creating it: %s", err)
 	}
 
-	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), "", v.(string))
-			if err == nil {
-				if route != nil {
-					return nil
-				} else {
-					err = errors.New("Route not found")
-				}
-			}
+	route := outputRaw.(*ec2.Route)
 
-			return resource.RetryableError(err)
-		})
-		if isResourceTimeoutError(err) {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), "", v.(string))
+	d.SetId(resourceAwsRouteID(d, route))
+
+	// The network interface of an instance target is only known once the route is active.
+	if setTarget == "instance_id" {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{routeStatePending},
+			Target:     []string{ec2.RouteStateActive},
+			Refresh:    routeInstanceTargetStateRefresh(conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string), d.Get("destination_ipv6_cidr_block").(string)),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 2 * time.Second,
 		}
-		if err != nil {
-			return fmt.Errorf("Error finding route after creating it: %s", err)
+		if _, ok := d.GetOk("poll_interval"); ok {
+			stateConf.Delay = minInterval
+			stateConf.PollInterval = minInterval
 		}
-		if route == nil {
-			return fmt.Errorf("Unable to find matching route for Route Table (%s) and destination IPv6 CIDR block (%s).", d.Get("route_table_id").(string), v)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for route (%s) to become active: %s", d.Id(), err)
 		}
 	}
 
-	d.SetId(resourceAwsRouteID(d, route))
+	if v, ok := d.GetOk("description"); ok {
+		if err := resourceAwsRouteUpdateDescription(conn, d, "", v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		if err := resourceAwsRouteUpdateTags(conn, d, nil, v); err != nil {
+			return err
+		}
+	}
 
 	return resourceAwsRouteRead(d, meta)
 }
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	routeTableId := d.Get("route_table_id").(string)
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
 	destinationIpv6CidrBlock := d.Get("destination_ipv6_cidr_block").(string)
+	destinationPrefixListId := d.Get("destination_prefix_list_id").(string)
+
+	// The destination may be missing from hand-edited state. Recover it from the ID.
+	if destinationCidrBlock == "" && destinationIpv6CidrBlock == "" && destinationPrefixListId == "" {
+		route, rtbID, err := resourceAwsRouteFindRouteByID(conn, routeTableId, d.Id())
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] %s, removing from state", err)
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		routeTableId = rtbID
+		destinationCidrBlock = aws.StringValue(route.DestinationCidrBlock)
+		destinationIpv6CidrBlock = aws.StringValue(route.DestinationIpv6CidrBlock)
+		destinationPrefixListId = aws.StringValue(route.DestinationPrefixListId)
+
+		log.Printf("[INFO] Recovered destination of route (%s) in Route Table (%s) from its ID", d.Id(), routeTableId)
+		d.Set("route_table_id", routeTableId)
+		d.Set("destination_cidr_block", destinationCidrBlock)
+		d.Set("destination_ipv6_cidr_block", destinationIpv6CidrBlock)
+		d.Set("destination_prefix_list_id", destinationPrefixListId)
+	}
 
-	route, err := resourceAwsRouteFindRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock)
-	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
-		log.Printf("[WARN] Route Table (%s) not found, removing from state", routeTableId)
+	route, routeTable, err := resourceAwsRouteFindRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock, destinationPrefixListId)
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] %s, removing from state", err)
 		d.SetId("")
 		return nil
 	}
@@ -390,15 +566,11 @@ func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if route == nil {
-		log.Printf("[WARN] Matching route not found, removing from state")
-		d.SetId("")
-		return nil
-	}
-
 	d.Set("destination_cidr_block", route.DestinationCidrBlock)
 	d.Set("destination_ipv6_cidr_block", route.DestinationIpv6CidrBlock)
 	d.Set("destination_prefix_list_id", route.DestinationPrefixListId)
+	d.Set("destination_is_prefix_list", resourceAwsRouteDestinationIsPrefixList(route))
+	d.Set("destination_managed_by_vpc_endpoint", resourceAwsRouteDestinationIsManagedByVpcEndpoint(route))
 	// VPC Endpoint ID is returned in Gateway ID field
 	if strings.HasPrefix(aws.StringValue(route.GatewayId), "vpce-") {
 		d.Set("vpc_endpoint_id", route.GatewayId)
@@ -412,209 +584,1107 @@ func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_owner_id", route.InstanceOwnerId)
 	d.Set("network_interface_id", route.NetworkInterfaceId)
 	d.Set("origin", route.Origin)
+	d.Set("propagated", aws.StringValue(route.Origin) == ec2.RouteOriginEnableVgwRoutePropagation)
 	d.Set("state", route.State)
 	d.Set("transit_gateway_id", route.TransitGatewayId)
 	d.Set("vpc_peering_connection_id", route.VpcPeeringConnectionId)
 
+	d.Set("owner_id", routeTable.OwnerId)
+
+	description := ""
+	tags := map[string]string{}
+	descriptionKey := resourceAwsRouteDescriptionTagKey(d.Id())
+	tagsKeyPrefix := resourceAwsRouteTagsKeyPrefix(d.Id())
+
+	for _, tag := range routeTable.Tags {
+		key := aws.StringValue(tag.Key)
+
+		switch {
+		case key == descriptionKey:
+			description = aws.StringValue(tag.Value)
+		case strings.HasPrefix(key, tagsKeyPrefix):
+			tags[strings.TrimPrefix(key, tagsKeyPrefix)] = aws.StringValue(tag.Value)
+		}
+	}
+	d.Set("description", description)
+
+	if err := d.Set("tags", keyvaluetags.New(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
 	return nil
 }
 
+// resourceAwsRouteUpdateContext updates the route, warning about overlapping routes when warn_on_overlapping_routes
+// is set and the destination changed.
+func resourceAwsRouteUpdateContext(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceAwsRouteUpdate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !d.HasChanges("destination_cidr_block", "destination_ipv6_cidr_block") {
+		return nil
+	}
+
+	return resourceAwsRouteOverlappingRoutesDiagnostics(d, meta)
+}
+
 func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	var numTargets int
-	var setTarget string
 
-	allowedTargets := []string{
-		"egress_only_gateway_id",
-		"gateway_id",
-		"nat_gateway_id",
-		"local_gateway_id",
-		"network_interface_id",
-		"instance_id",
-		"transit_gateway_id",
-		"vpc_endpoint_id",
-		"vpc_peering_connection_id",
-	}
-	// Check if more than 1 target is specified
-	for _, target := range allowedTargets {
-		if len(d.Get(target).(string)) > 0 {
-			numTargets++
-			setTarget = target
+	allowedTargets := routeTargets()
+
+	destinationChanged := d.HasChanges("destination_cidr_block", "destination_ipv6_cidr_block")
+
+	if destinationChanged {
+		if err := resourceAwsRouteChangeDestination(conn, d); err != nil {
+			return err
 		}
 	}
 
-	switch setTarget {
-	//instance_id is a special case due to the fact that AWS will "discover" the network_interface_id
-	//when it creates the route and return that data.  In the case of an update, we should ignore the
-	//existing network_interface_id
-	case "instance_id":
-		if numTargets > 2 || (numTargets == 2 && len(d.Get("network_interface_id").(string)) == 0) {
-			return routeTargetValidationError
-		}
-	default:
-		if numTargets > 1 {
-			return routeTargetValidationError
+	if d.HasChanges(allowedTargets...) {
+		if err := resourceAwsRouteReplaceTarget(conn, d, allowedTargets); err != nil {
+			return err
 		}
 	}
 
-	var replaceOpts *ec2.ReplaceRouteInput
-	// Formulate ReplaceRouteInput based on the target type
-	switch setTarget {
-	case "gateway_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			GatewayId:            aws.String(d.Get("gateway_id").(string)),
-		}
-	case "egress_only_gateway_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:                aws.String(d.Get("route_table_id").(string)),
-			DestinationIpv6CidrBlock:    aws.String(d.Get("destination_ipv6_cidr_block").(string)),
-			EgressOnlyInternetGatewayId: aws.String(d.Get("egress_only_gateway_id").(string)),
-		}
-	case "nat_gateway_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			NatGatewayId:         aws.String(d.Get("nat_gateway_id").(string)),
-		}
-	case "local_gateway_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			LocalGatewayId:       aws.String(d.Get("local_gateway_id").(string)),
-		}
-	case "instance_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			InstanceId:           aws.String(d.Get("instance_id").(string)),
-		}
-	case "network_interface_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			NetworkInterfaceId:   aws.String(d.Get("network_interface_id").(string)),
-		}
-	case "transit_gateway_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			TransitGatewayId:     aws.String(d.Get("transit_gateway_id").(string)),
-		}
-	case "vpc_endpoint_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:         aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock: aws.String(d.Get("destination_cidr_block").(string)),
-			VpcEndpointId:        aws.String(d.Get("vpc_endpoint_id").(string)),
+	// The description and tags were moved along with the route.
+	if !destinationChanged && d.HasChange("description") {
+		o, n := d.GetChange("description")
+
+		if err := resourceAwsRouteUpdateDescription(conn, d, o.(string), n.(string)); err != nil {
+			return err
 		}
-	case "vpc_peering_connection_id":
-		replaceOpts = &ec2.ReplaceRouteInput{
-			RouteTableId:           aws.String(d.Get("route_table_id").(string)),
-			DestinationCidrBlock:   aws.String(d.Get("destination_cidr_block").(string)),
-			VpcPeeringConnectionId: aws.String(d.Get("vpc_peering_connection_id").(string)),
+	}
+
+	if !destinationChanged && d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := resourceAwsRouteUpdateTags(conn, d, o, n); err != nil {
+			return err
 		}
-	default:
-		return fmt.Errorf("An invalid target type specified: %s", setTarget)
 	}
-	log.Printf("[DEBUG] Route replace config: %s", replaceOpts)
 
-	// Replace the route
-	_, err := conn.ReplaceRoute(replaceOpts)
-	return err
+	// Refresh all targets so that the one replaced by ReplaceRoute is cleared from state.
+	return resourceAwsRouteRead(d, meta)
 }
 
-func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+// resourceAwsRouteChangeDestination moves the route to the destination that changed in configuration.
+// EC2 cannot change the destination of a route, so a route with the new destination and the same target
+// is created and the route with the old destination then deleted. Creating first means that a failure
+// leaves the existing route in place.
+func resourceAwsRouteChangeDestination(conn *ec2.EC2, d *schema.ResourceData) error {
+	routeTableID := d.Get("route_table_id").(string)
+	oldCidr, newCidr := d.GetChange("destination_cidr_block")
+	oldIpv6Cidr, newIpv6Cidr := d.GetChange("destination_ipv6_cidr_block")
+
+	route, _, err := resourceAwsRouteFindRoute(conn, routeTableID, oldCidr.(string), oldIpv6Cidr.(string), "")
+
+	if err != nil {
+		return fmt.Errorf("error reading route before changing its destination: %w", err)
+	}
+
+	createOpts := resourceAwsRouteCreateRouteInputFromRoute(routeTableID, route)
+	if v := newCidr.(string); v != "" {
+		createOpts.DestinationCidrBlock = aws.String(v)
+	}
+	if v := newIpv6Cidr.(string); v != "" {
+		createOpts.DestinationIpv6CidrBlock = aws.String(v)
+	}
+	log.Printf("[DEBUG] Route create config: %s", createOpts)
+
+	minInterval, maxInterval := resourceAwsRoutePollIntervals(d)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	_, err = tfresource.RetryWhenWithJitter(timeout, minInterval, maxInterval, func() (interface{}, error) {
+		return conn.CreateRoute(createOpts)
+	}, tfresource.RetryableAwsErrCodeEquals("InvalidParameterException", "InvalidTransitGatewayID.NotFound"))
+
+	if err != nil {
+		return fmt.Errorf("error creating route with new destination: %w", err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenWithJitter(timeout, minInterval, maxInterval, func() (interface{}, error) {
+		route, _, err := resourceAwsRouteFindRoute(conn, routeTableID, newCidr.(string), newIpv6Cidr.(string), "")
+
+		return route, err
+	}, tfresource.NotFound)
+
+	if err != nil {
+		return fmt.Errorf("error finding route after creating it: %w", err)
+	}
 
 	deleteOpts := &ec2.DeleteRouteInput{
-		RouteTableId: aws.String(d.Get("route_table_id").(string)),
+		RouteTableId: aws.String(routeTableID),
 	}
-	if v, ok := d.GetOk("destination_cidr_block"); ok {
-		deleteOpts.DestinationCidrBlock = aws.String(v.(string))
+	if v := oldCidr.(string); v != "" {
+		deleteOpts.DestinationCidrBlock = aws.String(v)
 	}
-	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
-		deleteOpts.DestinationIpv6CidrBlock = aws.String(v.(string))
+	if v := oldIpv6Cidr.(string); v != "" {
+		deleteOpts.DestinationIpv6CidrBlock = aws.String(v)
 	}
 	log.Printf("[DEBUG] Route delete opts: %s", deleteOpts)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		log.Printf("[DEBUG] Trying to delete route with opts %s", deleteOpts)
-		var err error
-		_, err = conn.DeleteRoute(deleteOpts)
-		if err == nil {
-			return nil
-		}
+	_, err = tfresource.RetryWhenWithJitter(timeout, minInterval, maxInterval, func() (interface{}, error) {
+		return conn.DeleteRoute(deleteOpts)
+	}, tfresource.RetryableAwsErrCodeEquals("InvalidParameterException"))
 
-		if isAWSErr(err, "InvalidRoute.NotFound", "") {
-			return nil
-		}
+	if err != nil && !tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteNotFound) {
+		return fmt.Errorf("error deleting route with previous destination: %w", err)
+	}
 
-		if isAWSErr(err, "InvalidParameterException", "") {
-			return resource.RetryableError(err)
-		}
+	oldID := d.Id()
+	d.SetId(resourceAwsRouteID(d, outputRaw.(*ec2.Route)))
 
-		return resource.NonRetryableError(err)
-	})
-	if isResourceTimeoutError(err) {
-		_, err = conn.DeleteRoute(deleteOpts)
+	return resourceAwsRouteMoveDescriptionAndTags(conn, d, oldID)
+}
+
+// resourceAwsRouteMoveDescriptionAndTags moves the route table tags holding the route's description and tags
+// from the keys derived from the route's previous ID to those derived from its current one,
+// applying any change to their values.
+func resourceAwsRouteMoveDescriptionAndTags(conn *ec2.EC2, d *schema.ResourceData, oldID string) error {
+	routeTableID := d.Get("route_table_id").(string)
+	oldDescription, newDescription := d.GetChange("description")
+	oldTagsMap, newTagsMap := d.GetChange("tags")
+
+	oldTags := map[string]interface{}{}
+	if v := oldDescription.(string); v != "" {
+		oldTags[resourceAwsRouteDescriptionTagKey(oldID)] = v
 	}
-	if isAWSErr(err, "InvalidRoute.NotFound", "") {
-		return nil
+	oldPrefix := resourceAwsRouteTagsKeyPrefix(oldID)
+	for k, v := range keyvaluetags.New(oldTagsMap).Map() {
+		oldTags[oldPrefix+k] = v
 	}
-	if err != nil {
-		return fmt.Errorf("Error deleting route: %s", err)
+
+	newTags := map[string]interface{}{}
+	if v := newDescription.(string); v != "" {
+		newTags[resourceAwsRouteDescriptionTagKey(d.Id())] = v
 	}
+	newPrefix := resourceAwsRouteTagsKeyPrefix(d.Id())
+	for k, v := range keyvaluetags.New(newTagsMap).Map() {
+		newTags[newPrefix+k] = v
+	}
+
+	if err := keyvaluetags.Ec2UpdateTags(conn, routeTableID, oldTags, newTags); err != nil {
+		return fmt.Errorf("error updating Route Table (%s) route description and tags: %w", routeTableID, err)
+	}
+
 	return nil
 }
 
-// Helper: Create an ID for a route
-func resourceAwsRouteID(d *schema.ResourceData, r *ec2.Route) string {
+// resourceAwsRouteReplaceTarget points the route at the target that changed in configuration.
+// Targets are Optional and Computed, so a target removed from configuration keeps its prior value in
+// the plan; only the newly-set target shows up as a change.
+func resourceAwsRouteReplaceTarget(conn *ec2.EC2, d *schema.ResourceData, allowedTargets []string) error {
+	changedTargets := map[string]string{}
+	for _, target := range allowedTargets {
+		if !d.HasChange(target) {
+			continue
+		}
 
-	if r.DestinationIpv6CidrBlock != nil && *r.DestinationIpv6CidrBlock != "" {
-		return fmt.Sprintf("r-%s%d", d.Get("route_table_id").(string), hashcode.String(*r.DestinationIpv6CidrBlock))
+		if v := d.Get(target).(string); v != "" {
+			changedTargets[target] = v
+		}
 	}
 
-	return fmt.Sprintf("r-%s%d", d.Get("route_table_id").(string), hashcode.String(*r.DestinationCidrBlock))
-}
+	setTarget, err := resourceAwsRouteChangedTarget(changedTargets)
 
-// resourceAwsRouteFindRoute returns any route whose destination is the specified IPv4 or IPv6 CIDR block.
-// Returns nil if the route table exists but no matching destination is found.
-func resourceAwsRouteFindRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string) (*ec2.Route, error) {
-	routeTableID := rtbid
+	if err != nil {
+		return err
+	}
 
-	findOpts := &ec2.DescribeRouteTablesInput{
-		RouteTableIds: []*string{&routeTableID},
+	replaceOpts := &ec2.ReplaceRouteInput{
+		RouteTableId: aws.String(d.Get("route_table_id").(string)),
 	}
 
-	resp, err := conn.DescribeRouteTables(findOpts)
-	if err != nil {
-		return nil, err
+	if v, ok := d.GetOk("destination_cidr_block"); ok {
+		replaceOpts.DestinationCidrBlock = aws.String(v.(string))
 	}
 
-	if len(resp.RouteTables) < 1 || resp.RouteTables[0] == nil {
-		return nil, nil
+	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
+		replaceOpts.DestinationIpv6CidrBlock = aws.String(v.(string))
 	}
 
-	if cidr != "" {
-		for _, route := range (*resp.RouteTables[0]).Routes {
-			if route.DestinationCidrBlock != nil && *route.DestinationCidrBlock == cidr {
-				return route, nil
-			}
+	// Formulate ReplaceRouteInput based on the target type
+	switch setTarget {
+	case "gateway_id":
+		replaceOpts.GatewayId = aws.String(d.Get("gateway_id").(string))
+	case "egress_only_gateway_id":
+		replaceOpts.EgressOnlyInternetGatewayId = aws.String(d.Get("egress_only_gateway_id").(string))
+	case "nat_gateway_id":
+		replaceOpts.NatGatewayId = aws.String(d.Get("nat_gateway_id").(string))
+	case "local_gateway_id":
+		replaceOpts.LocalGatewayId = aws.String(d.Get("local_gateway_id").(string))
+	case "instance_id":
+		replaceOpts.InstanceId = aws.String(d.Get("instance_id").(string))
+	case "network_interface_id":
+		replaceOpts.NetworkInterfaceId = aws.String(d.Get("network_interface_id").(string))
+	case "transit_gateway_id":
+		transitGatewayID, err := tfec2.TransitGatewayIDFromARNOrID(d.Get("transit_gateway_id").(string))
+
+		if err != nil {
+			return err
 		}
 
-		return nil, nil
+		replaceOpts.TransitGatewayId = aws.String(transitGatewayID)
+	case "vpc_endpoint_id":
+		replaceOpts.VpcEndpointId = aws.String(d.Get("vpc_endpoint_id").(string))
+	case "vpc_peering_connection_id":
+		replaceOpts.VpcPeeringConnectionId = aws.String(d.Get("vpc_peering_connection_id").(string))
+	default:
+		return fmt.Errorf("An invalid target type specified: %s", setTarget)
 	}
+	log.Printf("[DEBUG] Route replace config: %s", replaceOpts)
 
-	if ipv6cidr != "" {
-		for _, route := range (*resp.RouteTables[0]).Routes {
-			if cidrBlocksEqual(aws.StringValue(route.DestinationIpv6CidrBlock), ipv6cidr) {
-				return route, nil
-			}
+	if setTarget == "instance_id" && d.Get("validate_instance_state").(bool) {
+		if err := resourceAwsRouteValidateInstanceState(conn, d.Get("instance_id").(string)); err != nil {
+			return fmt.Errorf("Error replacing route: %w", err)
 		}
+	}
+
+	minInterval, maxInterval := resourceAwsRoutePollIntervals(d)
+
+	if err := resourceAwsRouteReplaceRoute(conn, replaceOpts, d.Timeout(schema.TimeoutUpdate), minInterval, maxInterval); err != nil {
+		return fmt.Errorf("Error replacing route: %w", err)
+	}
+
+	return nil
+}
+
+// resourceAwsRouteReplaceRoute points the route at a new target. If the route was deleted outside of
+// Terraform since it was last refreshed, it is recreated with the new target instead.
+func resourceAwsRouteReplaceRoute(conn *ec2.EC2, input *ec2.ReplaceRouteInput, timeout, minInterval, maxInterval time.Duration) error {
+	_, err := conn.ReplaceRoute(input)
+
+	if !tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteNotFound) {
+		return err
+	}
+
+	log.Printf("[WARN] Route in Route Table (%s) not found, creating it", aws.StringValue(input.RouteTableId))
+	if _, err := conn.CreateRoute(resourceAwsRouteCreateRouteInputFromReplace(input)); err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryWhenWithJitter(timeout, minInterval, maxInterval, func() (interface{}, error) {
+		route, _, err := resourceAwsRouteFindRoute(conn, aws.StringValue(input.RouteTableId), aws.StringValue(input.DestinationCidrBlock), aws.StringValue(input.DestinationIpv6CidrBlock), "")
+
+		return route, err
+	}, tfresource.NotFound)
+
+	return err
+}
+
+// resourceAwsRouteChangedTarget returns the single route target among changedTargets, a map of
+// target attribute name to its new, non-empty value.
+func resourceAwsRouteChangedTarget(changedTargets map[string]string) (string, error) {
+	// AWS discovers the network interface of an instance target, so both change together.
+	if _, ok := changedTargets["instance_id"]; ok && len(changedTargets) == 2 {
+		if _, ok := changedTargets["network_interface_id"]; ok {
+			return "instance_id", nil
+		}
+	}
+
+	if len(changedTargets) > 1 {
+		return "", routeTargetValidationError
+	}
+
+	for target := range changedTargets {
+		return target, nil
+	}
+
+	return "", errors.New("A valid target type is missing. Specify one of the following attributes: " +
+		"gateway_id, egress_only_gateway_id, nat_gateway_id, instance_id, network_interface_id, local_gateway_id, " +
+		"transit_gateway_id, vpc_endpoint_id, vpc_peering_connection_id")
+}
+
+func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	deleteOpts := &ec2.DeleteRouteInput{
+		RouteTableId: aws.String(d.Get("route_table_id").(string)),
+	}
+	if v, ok := d.GetOk("destination_cidr_block"); ok {
+		deleteOpts.DestinationCidrBlock = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
+		deleteOpts.DestinationIpv6CidrBlock = aws.String(v.(string))
+	}
+	log.Printf("[DEBUG] Route delete opts: %s", deleteOpts)
+
+	route, _, err := resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string), d.Get("destination_ipv6_cidr_block").(string), d.Get("destination_prefix_list_id").(string))
+
+	// The route may have been deleted outside of Terraform, but its description and tags are still held by the route table.
+	if tfresource.NotFound(err) {
+		return resourceAwsRouteDeleteDescriptionAndTags(conn, d)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading route before deletion: %w", err)
+	}
+
+	// Routes to gateway VPC endpoints are removed by disassociating the route table from the endpoint.
+	if resourceAwsRouteDestinationIsManagedByVpcEndpoint(route) {
+		log.Printf("[WARN] Route in Route Table (%s) to VPC Endpoint (%s) is managed by the endpoint, skipping deletion", d.Get("route_table_id").(string), aws.StringValue(route.GatewayId))
+		return resourceAwsRouteDeleteDescriptionAndTags(conn, d)
+	}
+
+	minInterval, maxInterval := resourceAwsRoutePollIntervals(d)
+
+	_, err = tfresource.RetryWhenWithJitter(d.Timeout(schema.TimeoutDelete), minInterval, maxInterval, func() (interface{}, error) {
+		log.Printf("[DEBUG] Trying to delete route with opts %s", deleteOpts)
+		return conn.DeleteRoute(deleteOpts)
+	}, tfresource.RetryableAwsErrCodeEquals("InvalidParameterException"))
+
+	// The route table may have been deleted before the route.
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+		return nil
+	}
+	if err != nil && !tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteNotFound) {
+		return fmt.Errorf("Error deleting route: %s", err)
+	}
+
+	return resourceAwsRouteDeleteDescriptionAndTags(conn, d)
+}
+
+// resourceAwsRouteDeleteDescriptionAndTags removes the route table tags holding the route's description and tags.
+// There is nothing to remove if the route table no longer exists.
+func resourceAwsRouteDeleteDescriptionAndTags(conn *ec2.EC2, d *schema.ResourceData) error {
+	if v, ok := d.GetOk("description"); ok {
+		err := resourceAwsRouteUpdateDescription(conn, d, v.(string), "")
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		err := resourceAwsRouteUpdateTags(conn, d, v, nil)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsRouteDescriptionTagKey returns the key of the route table tag holding the route's description.
+// Route IDs never contain a colon, so the key cannot collide with those of the route's tags.
+func resourceAwsRouteDescriptionTagKey(id string) string {
+	return routeTagKeyPrefix + id
+}
+
+// resourceAwsRouteValidateInstanceState returns an error if the specified EC2 instance doesn't exist
+// or is not pending or running, as routes to such instances silently blackhole traffic.
+func resourceAwsRouteValidateInstanceState(conn *ec2.EC2, instanceID string) error {
+	// The instance may have just been created.
+	outputRaw, err := tfresource.RetryWhenAwsErrCodeEquals(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.InstanceByID(conn, instanceID)
+	}, tfec2.ErrCodeInvalidInstanceIDNotFound)
+
+	instance, _ := outputRaw.(*ec2.Instance)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidInstanceIDNotFound) || (err == nil && instance == nil) {
+		return fmt.Errorf("EC2 Instance (%s) not found", instanceID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", instanceID, err)
+	}
+
+	if instance.State == nil {
+		return nil
+	}
+
+	switch state := aws.StringValue(instance.State.Name); state {
+	case ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning:
+		return nil
+	default:
+		return fmt.Errorf("EC2 Instance (%s) is %s: traffic routed to an instance that is not pending or running is dropped", instanceID, state)
+	}
+}
+
+// resourceAwsRouteValidateNotPropagated returns an error if the route with the specified destination
+// was propagated from a virtual private gateway. Such routes are not created by Terraform and
+// are withdrawn by the gateway, so taking them over would leave Terraform fighting the propagation.
+func resourceAwsRouteValidateNotPropagated(conn *ec2.EC2, routeTableID, destination string) error {
+	cidr, ipv6cidr, prefixListID := destination, "", ""
+	switch {
+	case strings.HasPrefix(destination, "pl-"):
+		cidr, prefixListID = "", destination
+	case strings.Contains(destination, ":"):
+		cidr, ipv6cidr = "", destination
+	}
+
+	route, _, err := resourceAwsRouteFindRoute(conn, routeTableID, cidr, ipv6cidr, prefixListID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading route: %w", err)
+	}
+
+	if aws.StringValue(route.Origin) == ec2.RouteOriginEnableVgwRoutePropagation {
+		return fmt.Errorf("route in Route Table (%s) with destination (%s) is propagated from a virtual private gateway and cannot be managed by Terraform", routeTableID, destination)
+	}
+
+	return nil
+}
+
+// resourceAwsRouteTagsKeyPrefix returns the prefix of the keys of the route table tags holding the route's tags.
+func resourceAwsRouteTagsKeyPrefix(id string) string {
+	return routeTagKeyPrefix + id + ":"
+}
+
+// resourceAwsRouteUpdateTags stores the route's tags as tags on its route table,
+// with keys prefixed by the route's ID so that they cannot collide with the route table's own tags.
+func resourceAwsRouteUpdateTags(conn *ec2.EC2, d *schema.ResourceData, oldTagsMap, newTagsMap interface{}) error {
+	routeTableID := d.Get("route_table_id").(string)
+	prefix := resourceAwsRouteTagsKeyPrefix(d.Id())
+
+	oldTags := map[string]interface{}{}
+	for k, v := range keyvaluetags.New(oldTagsMap).Map() {
+		oldTags[prefix+k] = v
+	}
+
+	newTags := map[string]interface{}{}
+	for k, v := range keyvaluetags.New(newTagsMap).Map() {
+		newTags[prefix+k] = v
+	}
+
+	if err := keyvaluetags.Ec2UpdateTags(conn, routeTableID, oldTags, newTags); err != nil {
+		return fmt.Errorf("error updating Route Table (%s) route tags: %w", routeTableID, err)
+	}
+
+	return nil
+}
+
+// resourceAwsRouteUpdateDescription stores the route's description as a tag on its route table.
+// An empty description removes the tag.
+func resourceAwsRouteUpdateDescription(conn *ec2.EC2, d *schema.ResourceData, oldDescription, newDescription string) error {
+	routeTableID := d.Get("route_table_id").(string)
+	key := resourceAwsRouteDescriptionTagKey(d.Id())
+
+	oldTags := map[string]interface{}{}
+	if oldDescription != "" {
+		oldTags[key] = oldDescription
+	}
+
+	newTags := map[string]interface{}{}
+	if newDescription != "" {
+		newTags[key] = newDescription
+	}
+
+	if err := keyvaluetags.Ec2UpdateTags(conn, routeTableID, oldTags, newTags); err != nil {
+		return fmt.Errorf("error updating Route Table (%s) route description: %w", routeTableID, err)
+	}
+
+	return nil
+}
+
+// resourceAwsRouteReplaceRouteInputFromCreate returns the ReplaceRoute input that points
+// the route described by the specified CreateRoute input at the same target.
+func resourceAwsRouteReplaceRouteInputFromCreate(input *ec2.CreateRouteInput) *ec2.ReplaceRouteInput {
+	return &ec2.ReplaceRouteInput{
+		DestinationCidrBlock:        input.DestinationCidrBlock,
+		DestinationIpv6CidrBlock:    input.DestinationIpv6CidrBlock,
+		EgressOnlyInternetGatewayId: input.EgressOnlyInternetGatewayId,
+		GatewayId:                   input.GatewayId,
+		InstanceId:                  input.InstanceId,
+		LocalGatewayId:              input.LocalGatewayId,
+		NatGatewayId:                input.NatGatewayId,
+		NetworkInterfaceId:          input.NetworkInterfaceId,
+		RouteTableId:                input.RouteTableId,
+		TransitGatewayId:            input.TransitGatewayId,
+		VpcEndpointId:               input.VpcEndpointId,
+		VpcPeeringConnectionId:      input.VpcPeeringConnectionId,
+	}
+}
+
+// resourceAwsRoutePollIntervals returns the minimum and maximum interval between retries of route API calls.
+// A configured poll_interval replaces the default jittered interval.
+func resourceAwsRoutePollIntervals(d *schema.ResourceData) (time.Duration, time.Duration) {
+	if v, ok := d.GetOk("poll_interval"); ok {
+		if interval, err := time.ParseDuration(v.(string)); err == nil {
+			return interval, interval
+		}
+	}
+
+	return routeRetryMinInterval, routeRetryMaxInterval
+}
+
+func validateRoutePollInterval(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < routeRetryMinInterval || duration > 60*time.Second {
+		errors = append(errors, fmt.Errorf("%q must be between %s and 60s", k, routeRetryMinInterval))
+	}
+
+	return
+}
+
+// validateRouteTableID validates that the value is a VPC route table ID.
+// Client VPN endpoints and transit gateway route tables have their own routes, which
+// cannot be managed with aws_route, so passing one of their IDs is reported with a pointer
+// to the matching resource rather than failing later with an error from DescribeRouteTables.
+func validateRouteTableID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	switch {
+	case strings.HasPrefix(value, "rtb-"):
+	case strings.HasPrefix(value, "cvpn-endpoint-"):
+		errors = append(errors, fmt.Errorf("%q (%s) is a Client VPN endpoint ID: use the aws_ec2_client_vpn_route resource to manage Client VPN routes", k, value))
+	case strings.HasPrefix(value, "tgw-rtb-"):
+		errors = append(errors, fmt.Errorf("%q (%s) is a transit gateway route table ID: use the aws_ec2_transit_gateway_route resource to manage transit gateway routes", k, value))
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be a VPC route table ID beginning with \"rtb-\"", k, value))
+	}
+
+	return
+}
+
+// resourceAwsRouteCreateRouteInputFromReplace returns the CreateRoute input that creates
+// the route described by the specified ReplaceRoute input.
+func resourceAwsRouteCreateRouteInputFromReplace(input *ec2.ReplaceRouteInput) *ec2.CreateRouteInput {
+	return &ec2.CreateRouteInput{
+		DestinationCidrBlock:        input.DestinationCidrBlock,
+		DestinationIpv6CidrBlock:    input.DestinationIpv6CidrBlock,
+		EgressOnlyInternetGatewayId: input.EgressOnlyInternetGatewayId,
+		GatewayId:                   input.GatewayId,
+		InstanceId:                  input.InstanceId,
+		LocalGatewayId:              input.LocalGatewayId,
+		NatGatewayId:                input.NatGatewayId,
+		NetworkInterfaceId:          input.NetworkInterfaceId,
+		RouteTableId:                input.RouteTableId,
+		TransitGatewayId:            input.TransitGatewayId,
+		VpcEndpointId:               input.VpcEndpointId,
+		VpcPeeringConnectionId:      input.VpcPeeringConnectionId,
+	}
+}
+
+// resourceAwsRouteCreateRouteInputFromRoute returns the CreateRoute input that creates a route
+// in the specified route table with the same target as the specified route. The destination is left unset.
+func resourceAwsRouteCreateRouteInputFromRoute(routeTableID string, route *ec2.Route) *ec2.CreateRouteInput {
+	input := &ec2.CreateRouteInput{
+		EgressOnlyInternetGatewayId: route.EgressOnlyInternetGatewayId,
+		LocalGatewayId:              route.LocalGatewayId,
+		NatGatewayId:                route.NatGatewayId,
+		RouteTableId:                aws.String(routeTableID),
+		TransitGatewayId:            route.TransitGatewayId,
+		VpcPeeringConnectionId:      route.VpcPeeringConnectionId,
+	}
+
+	// VPC Endpoint ID is returned in Gateway ID field
+	if strings.HasPrefix(aws.StringValue(route.GatewayId), "vpce-") {
+		input.VpcEndpointId = route.GatewayId
+	} else {
+		input.GatewayId = route.GatewayId
+	}
+
+	// AWS discovers the network interface of an instance target, so only one of them may be specified.
+	if aws.StringValue(route.InstanceId) != "" {
+		input.InstanceId = route.InstanceId
+	} else {
+		input.NetworkInterfaceId = route.NetworkInterfaceId
+	}
+
+	return input
+}
+
+// resourceAwsRouteTargetMatches returns whether the route still points at the targets recorded in state.
+func resourceAwsRouteTargetMatches(d *schema.ResourceData, route *ec2.Route) bool {
+	gatewayID := aws.StringValue(route.GatewayId)
+	vpcEndpointID := ""
+	// VPC Endpoint ID is returned in Gateway ID field
+	if strings.HasPrefix(gatewayID, "vpce-") {
+		vpcEndpointID = gatewayID
+		gatewayID = ""
+	}
+
+	targets := map[string]string{
+		"egress_only_gateway_id":    aws.StringValue(route.EgressOnlyInternetGatewayId),
+		"gateway_id":                gatewayID,
+		"instance_id":               aws.StringValue(route.InstanceId),
+		"local_gateway_id":          aws.StringValue(route.LocalGatewayId),
+		"nat_gateway_id":            aws.StringValue(route.NatGatewayId),
+		"network_interface_id":      aws.StringValue(route.NetworkInterfaceId),
+		"transit_gateway_id":        aws.StringValue(route.TransitGatewayId),
+		"vpc_endpoint_id":           vpcEndpointID,
+		"vpc_peering_connection_id": aws.StringValue(route.VpcPeeringConnectionId),
+	}
+
+	for k, v := range targets {
+		if old := d.Get(k).(string); old != "" && old != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func routeInstanceTargetStateRefresh(conn *ec2.EC2, rtbid, cidr, ipv6cidr string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		route, _, err := resourceAwsRouteFindRoute(conn, rtbid, cidr, ipv6cidr, "")
+
+		if tfresource.NotFound(err) {
+			return nil, routeStatePending, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := aws.StringValue(route.State)
+		if state == ec2.RouteStateActive && aws.StringValue(route.NetworkInterfaceId) == "" {
+			state = routeStatePending
+		}
+
+		return route, state, nil
+	}
+}
+
+// resourceAwsRouteDestinationIsPrefixList returns whether the route's destination is a managed prefix list
+// rather than an IPv4 or IPv6 CIDR block. Routes added by gateway VPC endpoints also have a prefix list
+// destination, the prefix list of the endpoint's service, but are reported by
+// resourceAwsRouteDestinationIsManagedByVpcEndpoint instead.
+func resourceAwsRouteDestinationIsPrefixList(route *ec2.Route) bool {
+	return resourceAwsRouteHasPrefixListDestination(route) && !strings.HasPrefix(aws.StringValue(route.GatewayId), "vpce-")
+}
+
+// resourceAwsRouteDestinationIsManagedByVpcEndpoint returns whether the route was added to the route table
+// by a gateway VPC endpoint, which populates the destination with the prefix list of the endpoint's service.
+// Such routes exist for as long as the route table is associated with the endpoint.
+func resourceAwsRouteDestinationIsManagedByVpcEndpoint(route *ec2.Route) bool {
+	return resourceAwsRouteHasPrefixListDestination(route) && strings.HasPrefix(aws.StringValue(route.GatewayId), "vpce-")
+}
+
+// resourceAwsRouteHasPrefixListDestination returns whether the route's destination is a prefix list of any kind.
+func resourceAwsRouteHasPrefixListDestination(route *ec2.Route) bool {
+	if route == nil || aws.StringValue(route.DestinationPrefixListId) == "" {
+		return false
+	}
+
+	return aws.StringValue(route.DestinationCidrBlock) == "" && aws.StringValue(route.DestinationIpv6CidrBlock) == ""
+}
+
+// resourceAwsRouteParseImportID splits an import ID of the form ROUTETABLEID_DESTINATION.
+// Route table IDs never contain an underscore, so only the first one is significant
+// and the destination is returned intact.
+func resourceAwsRouteParseImportID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "_", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// Helper: Create an ID for a route
+func resourceAwsRouteID(d *schema.ResourceData, r *ec2.Route) string {
+	return resourceAwsRouteIDForRouteTable(d.Get("route_table_id").(string), r)
+}
+
+// resourceAwsRouteIDForRouteTable returns the ID of the route in the specified route table.
+func resourceAwsRouteIDForRouteTable(rtbid string, r *ec2.Route) string {
+	if r.DestinationIpv6CidrBlock != nil && *r.DestinationIpv6CidrBlock != "" {
+		return fmt.Sprintf("r-%s%d", rtbid, hashcode.String(*r.DestinationIpv6CidrBlock))
+	}
+
+	if resourceAwsRouteHasPrefixListDestination(r) {
+		return fmt.Sprintf("r-%s%d", rtbid, hashcode.String(aws.StringValue(r.DestinationPrefixListId)))
+	}
+
+	return fmt.Sprintf("r-%s%d", rtbid, hashcode.String(aws.StringValue(r.DestinationCidrBlock)))
+}
+
+// resourceAwsRouteRouteTableIDsFromID returns the route table IDs that the specified route ID may have been built from.
+// The ID is the route table ID followed by the decimal hash of the destination. Route table IDs have
+// 8 or 17 hexadecimal digits, so an ID may be ambiguous and more than one candidate is returned.
+func resourceAwsRouteRouteTableIDsFromID(id string) []string {
+	const prefix = "r-rtb-"
+
+	if !strings.HasPrefix(id, prefix) {
+		return nil
+	}
+
+	var rtbids []string
+
+	for _, n := range []int{17, 8} {
+		end := len(prefix) + n
+		if len(id) <= end {
+			continue
+		}
+
+		if _, err := strconv.ParseUint(id[end:], 10, 64); err != nil {
+			continue
+		}
+
+		rtbids = append(rtbids, id[len("r-"):end])
+	}
+
+	return rtbids
+}
+
+// resourceAwsRouteFindRouteByID returns the route whose ID is the specified one, along with the ID of its route table.
+// If rtbid is empty, the route table ID is recovered from the route ID.
+// Returns a *resource.NotFoundError if no route has the ID.
+func resourceAwsRouteFindRouteByID(conn *ec2.EC2, rtbid, id string) (*ec2.Route, string, error) {
+	rtbids := []string{rtbid}
+	if rtbid == "" {
+		rtbids = resourceAwsRouteRouteTableIDsFromID(id)
+	}
+
+	for _, candidate := range rtbids {
+		routeTable, err := finder.RouteTableByID(conn, candidate)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading Route Table (%s): %w", candidate, err)
+		}
+
+		if routeTable == nil {
+			continue
+		}
+
+		for _, route := range routeTable.Routes {
+			if route == nil {
+				continue
+			}
+
+			if resourceAwsRouteIDForRouteTable(candidate, route) == id {
+				return route, candidate, nil
+			}
+		}
+	}
+
+	return nil, "", &resource.NotFoundError{
+		Message: fmt.Sprintf("Route (%s) not found", id),
+	}
+}
+
+// resourceAwsRouteFindRoute returns any route whose destination is the specified IPv4 or IPv6 CIDR block,
+// along with the route table containing it so that callers need not describe the table again.
+// Returns a *resource.NotFoundError if either the route table or a route with a matching destination is not found;
+// for a missing route table LastError holds the underlying EC2 API error, if any.
+func resourceAwsRouteFindRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string, prefixListID string) (*ec2.Route, *ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		RouteTableIds: aws.StringSlice([]string{rtbid}),
+	}
+
+	var result *ec2.Route
+	var resultRouteTable *ec2.RouteTable
+
+	// Routes of a table are not split across pages today, but iterate all of them
+	// so that a truncated response can never hide the destination.
+	err := conn.DescribeRouteTablesPages(input, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, routeTable := range page.RouteTables {
+			if routeTable == nil {
+				continue
+			}
+
+			resultRouteTable = routeTable
+
+			for _, route := range routeTable.Routes {
+				if route == nil {
+					continue
+				}
+
+				switch {
+				case cidr != "":
+					if aws.StringValue(route.DestinationCidrBlock) == cidr {
+						result = route
+						resultRouteTable = routeTable
+						return false
+					}
+				case ipv6cidr != "":
+					if cidrBlocksEqual(aws.StringValue(route.DestinationIpv6CidrBlock), ipv6cidr) {
+						result = route
+						resultRouteTable = routeTable
+						return false
+					}
+				case prefixListID != "":
+					if aws.StringValue(route.DestinationPrefixListId) == prefixListID {
+						result = route
+						resultRouteTable = routeTable
+						return false
+					}
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+		return nil, nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+			Message:     fmt.Sprintf("Route Table (%s) not found", rtbid),
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resultRouteTable == nil {
+		return nil, nil, &resource.NotFoundError{
+			LastRequest: input,
+			Message:     fmt.Sprintf("Route Table (%s) not found", rtbid),
+		}
+	}
+
+	if result == nil {
+		destination := cidr
+		if destination == "" {
+			destination = ipv6cidr
+		}
+		if destination == "" {
+			destination = prefixListID
+		}
+
+		return nil, nil, &resource.NotFoundError{
+			LastRequest: input,
+			Message:     fmt.Sprintf("Route in Route Table (%s) with destination (%s) not found", rtbid, destination),
+		}
+	}
+
+	return result, resultRouteTable, nil
+}
+
+// resourceAwsRouteCustomizeDiffDestinationChange forces a new resource when the destination of an existing route
+// changes, unless allow_destination_change_in_place is set and Update is to move the route instead.
+func resourceAwsRouteCustomizeDiffDestinationChange(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("allow_destination_change_in_place").(bool) {
+		return nil
+	}
+
+	for _, destinationKey := range []string{"destination_cidr_block", "destination_ipv6_cidr_block"} {
+		// Equal IPv6 CIDR blocks written differently are not a change.
+		if o, n := diff.GetChange(destinationKey); o.(string) == n.(string) || cidrBlocksEqual(o.(string), n.(string)) {
+			continue
+		}
+
+		if err := diff.ForceNew(destinationKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-		return nil, nil
+// resourceAwsRouteCustomizeDiffTarget errors at plan time when a new route has no target,
+// for example when every target argument is set to an empty string by a conditional expression.
+func resourceAwsRouteCustomizeDiffTarget(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Targets are Computed, so an existing route keeps its target in the plan.
+	if diff.Id() != "" {
+		return nil
+	}
+
+	targets := routeTargets()
+
+	for _, target := range targets {
+		if !diff.NewValueKnown(target) || diff.Get(target).(string) != "" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no route target specified. Specify one of the following attributes: %s", strings.Join(targets, ", "))
+}
+
+// resourceAwsRouteCustomizeDiffTargetAddressFamily errors at plan time when the route's target
+// does not support the address family of its destination.
+func resourceAwsRouteCustomizeDiffTargetAddressFamily(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, destinationKey := range []string{"destination_cidr_block", "destination_ipv6_cidr_block"} {
+		if !diff.NewValueKnown(destinationKey) {
+			continue
+		}
+
+		destination := diff.Get(destinationKey).(string)
+
+		if destination == "" {
+			continue
+		}
+
+		for target := range routeTargetAddressFamilies {
+			if !diff.NewValueKnown(target) || diff.Get(target).(string) == "" {
+				continue
+			}
+
+			if err := routeTargetSupportsDestination(target, destination); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// routeDestinationAddressFamily returns the address family of the specified destination CIDR block.
+func routeDestinationAddressFamily(destination string) (string, error) {
+	ip, _, err := net.ParseCIDR(destination)
+
+	if err != nil {
+		return "", err
+	}
+
+	if ip.To4() != nil {
+		return routeAddressFamilyIpv4, nil
+	}
+
+	return routeAddressFamilyIpv6, nil
+}
+
+// routeTargetSupportsDestination returns an error if the specified route target argument does not support
+// the address family of the destination CIDR block.
+// Invalid destinations are left to the destination argument's validation.
+func routeTargetSupportsDestination(target, destination string) error {
+	family, err := routeDestinationAddressFamily(destination)
+
+	if err != nil {
+		return nil
+	}
+
+	families, ok := routeTargetAddressFamilies[target]
+
+	if !ok {
+		return nil
+	}
+
+	for _, v := range families {
+		if v == family {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s does not support %s destinations", target, family)
+}
+
+// routeTableOwnerDiagnostics returns a warning when the route table is owned by an account other than the caller's,
+// such as a route table shared through AWS RAM.
+func routeTableOwnerDiagnostics(routeTableID, ownerID, accountID string) diag.Diagnostics {
+	if ownerID == "" || accountID == "" || ownerID == accountID {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Route Table (%s) is owned by another account", routeTableID),
+			Detail:   fmt.Sprintf("The route table is owned by account (%s), not the caller's account (%s).", ownerID, accountID),
+		},
+	}
+}
+
+// resourceAwsRouteOverlappingRoutesDiagnostics returns a warning for each other route in the route table whose
+// destination overlaps the route's destination. AWS selects the route with the longest prefix match, which is
+// often surprising. The check requires an additional API call so it is only performed when enabled.
+func resourceAwsRouteOverlappingRoutesDiagnostics(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Id() == "" || !d.Get("warn_on_overlapping_routes").(bool) {
+		return nil
+	}
+
+	routeTableID := d.Get("route_table_id").(string)
+	destination := d.Get("destination_cidr_block").(string)
+
+	if destination == "" {
+		destination = d.Get("destination_ipv6_cidr_block").(string)
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+
+	routeTable, err := finder.RouteTableByID(conn, routeTableID)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unable to check Route Table (%s) for overlapping routes", routeTableID),
+				Detail:   err.Error(),
+			},
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	for _, existing := range routeOverlappingDestinations(routeTable, destination) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Route Table (%s) destination (%s) overlaps existing route destination (%s)", routeTableID, destination, existing),
+			Detail:   "Traffic is routed using the longest prefix match.",
+		})
+	}
+
+	return diags
+}
+
+// routeOverlappingDestinations returns the destination CIDR blocks of the routes in the route table,
+// other than destination itself, that overlap the specified destination CIDR block.
+// The local route and default routes overlap most routes by design and are neither reported nor checked.
+func routeOverlappingDestinations(routeTable *ec2.RouteTable, destination string) []string {
+	_, destinationNet, err := net.ParseCIDR(destination)
+
+	if err != nil || routeTable == nil {
+		return nil
+	}
+
+	if ones, _ := destinationNet.Mask.Size(); ones == 0 {
+		return nil
+	}
+
+	var overlapping []string
+
+	for _, route := range routeTable.Routes {
+		if route == nil || aws.StringValue(route.GatewayId) == "local" {
+			continue
+		}
+
+		existing := aws.StringValue(route.DestinationCidrBlock)
+
+		if existing == "" {
+			existing = aws.StringValue(route.DestinationIpv6CidrBlock)
+		}
+
+		if existing == "" || cidrBlocksEqual(existing, destination) {
+			continue
+		}
+
+		_, existingNet, err := net.ParseCIDR(existing)
+
+		if err != nil {
+			continue
+		}
+
+		if ones, _ := existingNet.Mask.Size(); ones == 0 {
+			continue
+		}
+
+		if existingNet.Contains(destinationNet.IP) || destinationNet.Contains(existingNet.IP) {
+			overlapping = append(overlapping, existing)
+		}
 	}
 
-	return nil, nil
+	return overlapping
 }
@@ -0,0 +1,584 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// routeSetTargets are the target arguments of aws_route_set, exactly one of which is configured.
+var routeSetTargets = []string{
+	"egress_only_gateway_id",
+	"gateway_id",
+	"instance_id",
+	"local_gateway_id",
+	"nat_gateway_id",
+	"network_interface_id",
+	"transit_gateway_id",
+	"vpc_endpoint_id",
+	"vpc_peering_connection_id",
+}
+
+func resourceAwsRouteSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRouteSetCreate,
+		Read:   resourceAwsRouteSetRead,
+		Update: resourceAwsRouteSetUpdate,
+		Delete: resourceAwsRouteSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteSetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAwsRouteSetCustomizeDiffTargetAddressFamily,
+
+		Schema: map[string]*schema.Schema{
+			"destination_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						validateIpv4CIDRNetworkAddress,
+						validateIpv6CIDRNetworkAddress,
+					),
+				},
+			},
+
+			"egress_only_gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"local_gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+				ValidateFunc: validateEc2TransitGatewayIDOrARN,
+				// Store the identifier when the transit gateway is specified by ARN.
+				StateFunc: func(v interface{}) string {
+					id, err := tfec2.TransitGatewayIDFromARNOrID(v.(string))
+
+					if err != nil {
+						return v.(string)
+					}
+
+					return id
+				},
+			},
+
+			"validate_instance_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"vpc_endpoint_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+
+			"vpc_peering_connection_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: routeSetTargets,
+			},
+		},
+	}
+}
+
+func resourceAwsRouteSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	destinations := d.Get("destination_cidr_blocks").(*schema.Set).List()
+
+	if err := resourceAwsRouteSetCreateRoutes(conn, d, destinations, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(resourceAwsRouteSetID(d))
+
+	return resourceAwsRouteSetRead(d, meta)
+}
+
+func resourceAwsRouteSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	routeTableID := d.Get("route_table_id").(string)
+
+	routeTable, err := finder.RouteTableByID(conn, routeTableID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+		log.Printf("[WARN] Route Table (%s) not found, removing route set (%s) from state", routeTableID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", routeTableID, err)
+	}
+
+	if routeTable == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Route Table (%s): not found", routeTableID)
+		}
+
+		log.Printf("[WARN] Route Table (%s) not found, removing route set (%s) from state", routeTableID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Only the destinations still routed to the configured target are managed by this resource.
+	var destinations []string
+	for _, v := range d.Get("destination_cidr_blocks").(*schema.Set).List() {
+		destination := v.(string)
+		route := resourceAwsRouteSetRouteByDestination(routeTable, destination)
+
+		if route == nil {
+			log.Printf("[WARN] Route in Route Table (%s) with destination (%s) not found", routeTableID, destination)
+			continue
+		}
+
+		if !resourceAwsRouteTargetMatches(d, route) {
+			log.Printf("[WARN] Route in Route Table (%s) with destination (%s) no longer points at the configured target", routeTableID, destination)
+			continue
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	if len(destinations) == 0 && !d.IsNewResource() {
+		log.Printf("[WARN] No routes of route set (%s) found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("destination_cidr_blocks", destinations); err != nil {
+		return fmt.Errorf("error setting destination_cidr_blocks: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRouteSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	o, n := d.GetChange("destination_cidr_blocks")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	// Routes being removed still point at the prior target when the target changes.
+	if err := resourceAwsRouteSetDeleteRoutes(conn, d, os.Difference(ns).List(), !d.HasChanges(routeSetTargets...), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	if d.HasChanges(routeSetTargets...) {
+		if d.Get("instance_id").(string) != "" && d.Get("validate_instance_state").(bool) {
+			if err := resourceAwsRouteValidateInstanceState(conn, d.Get("instance_id").(string)); err != nil {
+				return fmt.Errorf("error replacing routes: %w", err)
+			}
+		}
+
+		for _, v := range os.Intersection(ns).List() {
+			input, err := resourceAwsRouteSetCreateRouteInput(d, v.(string))
+
+			if err != nil {
+				return err
+			}
+
+			replaceOpts := resourceAwsRouteReplaceRouteInputFromCreate(input)
+
+			log.Printf("[DEBUG] Route replace config: %s", replaceOpts)
+			if _, err := conn.ReplaceRoute(replaceOpts); err != nil {
+				return fmt.Errorf("error replacing route in Route Table (%s) with destination (%s): %w", d.Get("route_table_id").(string), v.(string), err)
+			}
+		}
+	}
+
+	if err := resourceAwsRouteSetCreateRoutes(conn, d, ns.Difference(os).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	d.SetId(resourceAwsRouteSetID(d))
+
+	return resourceAwsRouteSetRead(d, meta)
+}
+
+func resourceAwsRouteSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	return resourceAwsRouteSetDeleteRoutes(conn, d, d.Get("destination_cidr_blocks").(*schema.Set).List(), true, d.Timeout(schema.TimeoutDelete))
+}
+
+// resourceAwsRouteSetImport imports the routes with the specified destinations, which must all point at the same target.
+// The import ID is the route table ID and the comma-separated destinations, joined by an underscore.
+func resourceAwsRouteSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	routeTableID, destinationsPart, err := resourceAwsRouteParseImportID(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION1,DESTINATION2", d.Id())
+	}
+
+	if _, errs := validateRouteTableID(routeTableID, "route_table_id"); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	routeTable, err := finder.RouteTableByID(conn, routeTableID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Route Table (%s): %w", routeTableID, err)
+	}
+
+	if routeTable == nil {
+		return nil, fmt.Errorf("Route Table (%s) not found", routeTableID)
+	}
+
+	destinations := strings.Split(destinationsPart, ",")
+	target, targetID := "", ""
+
+	for _, destination := range destinations {
+		route := resourceAwsRouteSetRouteByDestination(routeTable, destination)
+
+		if route == nil {
+			return nil, fmt.Errorf("Route in Route Table (%s) with destination (%s) not found", routeTableID, destination)
+		}
+
+		// The local route and routes propagated from virtual private gateways are not created by Terraform.
+		if aws.StringValue(route.Origin) != ec2.RouteOriginCreateRoute {
+			return nil, fmt.Errorf("route in Route Table (%s) with destination (%s) was not created by CreateRoute and cannot be managed by Terraform", routeTableID, destination)
+		}
+
+		routeTarget, routeTargetID := routeTargetOfRoute(route)
+
+		if routeTarget == "" {
+			return nil, fmt.Errorf("route in Route Table (%s) with destination (%s) has no supported target", routeTableID, destination)
+		}
+
+		if target != "" && (routeTarget != target || routeTargetID != targetID) {
+			return nil, fmt.Errorf("routes in Route Table (%s) point at different targets (%s, %s)", routeTableID, targetID, routeTargetID)
+		}
+
+		target, targetID = routeTarget, routeTargetID
+	}
+
+	d.Set("route_table_id", routeTableID)
+	if err := d.Set("destination_cidr_blocks", destinations); err != nil {
+		return nil, fmt.Errorf("error setting destination_cidr_blocks: %w", err)
+	}
+	d.Set(target, targetID)
+	d.Set("validate_instance_state", false)
+	d.SetId(resourceAwsRouteSetID(d))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAwsRouteSetID returns the ID of the route set: its route table ID followed by the hash of its destinations.
+// As with aws_route, the ID changes when the destinations change.
+func resourceAwsRouteSetID(d *schema.ResourceData) string {
+	var destinations []string
+	for _, v := range d.Get("destination_cidr_blocks").(*schema.Set).List() {
+		destinations = append(destinations, v.(string))
+	}
+
+	sort.Strings(destinations)
+
+	return fmt.Sprintf("rs-%s%d", d.Get("route_table_id").(string), hashcode.String(strings.Join(destinations, ",")))
+}
+
+// routeTargetOfRoute returns the route target argument set by the route and its value.
+// The network interface of an instance target is not reported, as it follows from the instance.
+func routeTargetOfRoute(route *ec2.Route) (string, string) {
+	gatewayID := aws.StringValue(route.GatewayId)
+
+	switch {
+	case strings.HasPrefix(gatewayID, "vpce-"):
+		// VPC Endpoint ID is returned in Gateway ID field
+		return "vpc_endpoint_id", gatewayID
+	case gatewayID != "":
+		return "gateway_id", gatewayID
+	case aws.StringValue(route.EgressOnlyInternetGatewayId) != "":
+		return "egress_only_gateway_id", aws.StringValue(route.EgressOnlyInternetGatewayId)
+	case aws.StringValue(route.InstanceId) != "":
+		return "instance_id", aws.StringValue(route.InstanceId)
+	case aws.StringValue(route.LocalGatewayId) != "":
+		return "local_gateway_id", aws.StringValue(route.LocalGatewayId)
+	case aws.StringValue(route.NatGatewayId) != "":
+		return "nat_gateway_id", aws.StringValue(route.NatGatewayId)
+	case aws.StringValue(route.NetworkInterfaceId) != "":
+		return "network_interface_id", aws.StringValue(route.NetworkInterfaceId)
+	case aws.StringValue(route.TransitGatewayId) != "":
+		return "transit_gateway_id", aws.StringValue(route.TransitGatewayId)
+	case aws.StringValue(route.VpcPeeringConnectionId) != "":
+		return "vpc_peering_connection_id", aws.StringValue(route.VpcPeeringConnectionId)
+	}
+
+	return "", ""
+}
+
+// resourceAwsRouteSetCreateRoutes creates a route to the configured target for each of the specified destinations
+// and waits for the routes to be found.
+func resourceAwsRouteSetCreateRoutes(conn *ec2.EC2, d *schema.ResourceData, destinations []interface{}, timeout time.Duration) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	routeTableID := d.Get("route_table_id").(string)
+
+	if d.Get("instance_id").(string) != "" && d.Get("validate_instance_state").(bool) {
+		if err := resourceAwsRouteValidateInstanceState(conn, d.Get("instance_id").(string)); err != nil {
+			return fmt.Errorf("error creating routes: %w", err)
+		}
+	}
+
+	// The routes created before a failure are not recorded in state, so they are deleted again.
+	var created []interface{}
+
+	for _, v := range destinations {
+		destination := v.(string)
+		input, err := resourceAwsRouteSetCreateRouteInput(d, destination)
+
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Route create config: %s", input)
+		_, err = tfresource.RetryWhenWithJitter(timeout, routeRetryMinInterval, routeRetryMaxInterval, func() (interface{}, error) {
+			return conn.CreateRoute(input)
+		}, tfresource.RetryableAwsErrCodeEquals("InvalidParameterException", "InvalidTransitGatewayID.NotFound"))
+
+		if err != nil {
+			err = fmt.Errorf("error creating route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+
+			if rollbackErr := resourceAwsRouteSetDeleteRoutes(conn, d, created, true, timeout); rollbackErr != nil {
+				return multierror.Append(err, fmt.Errorf("error deleting routes created before the failure: %w", rollbackErr))
+			}
+
+			return err
+		}
+
+		created = append(created, v)
+	}
+
+	for _, v := range destinations {
+		cidr, ipv6cidr := resourceAwsRouteSetDestination(v.(string))
+
+		_, err := tfresource.RetryWhenWithJitter(timeout, routeRetryMinInterval, routeRetryMaxInterval, func() (interface{}, error) {
+			route, _, err := resourceAwsRouteFindRoute(conn, routeTableID, cidr, ipv6cidr, "")
+
+			return route, err
+		}, tfresource.NotFound)
+
+		if err != nil {
+			return fmt.Errorf("error finding route after creating it: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsRouteSetDeleteRoutes deletes the routes with the specified destinations.
+// Routes already deleted are skipped, as are routes taken over by another target when checkTarget is set.
+func resourceAwsRouteSetDeleteRoutes(conn *ec2.EC2, d *schema.ResourceData, destinations []interface{}, checkTarget bool, timeout time.Duration) error {
+	routeTableID := d.Get("route_table_id").(string)
+
+	for _, v := range destinations {
+		destination := v.(string)
+		cidr, ipv6cidr := resourceAwsRouteSetDestination(destination)
+
+		route, _, err := resourceAwsRouteFindRoute(conn, routeTableID, cidr, ipv6cidr, "")
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading route before deletion: %w", err)
+		}
+
+		if checkTarget && !resourceAwsRouteTargetMatches(d, route) {
+			log.Printf("[INFO] Route in Route Table (%s) with destination (%s) no longer points at the configured target, skipping deletion", routeTableID, destination)
+			continue
+		}
+
+		input := &ec2.DeleteRouteInput{
+			RouteTableId: aws.String(routeTableID),
+		}
+		if cidr != "" {
+			input.DestinationCidrBlock = aws.String(cidr)
+		} else {
+			input.DestinationIpv6CidrBlock = aws.String(ipv6cidr)
+		}
+
+		log.Printf("[DEBUG] Route delete opts: %s", input)
+		_, err = tfresource.RetryWhenWithJitter(timeout, routeRetryMinInterval, routeRetryMaxInterval, func() (interface{}, error) {
+			return conn.DeleteRoute(input)
+		}, tfresource.RetryableAwsErrCodeEquals("InvalidParameterException"))
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteNotFound) || tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsRouteSetCreateRouteInput returns the CreateRoute input for a route from the configured
+// route table to the configured target with the specified destination.
+func resourceAwsRouteSetCreateRouteInput(d *schema.ResourceData, destination string) (*ec2.CreateRouteInput, error) {
+	input := &ec2.CreateRouteInput{
+		RouteTableId: aws.String(d.Get("route_table_id").(string)),
+	}
+
+	if cidr, ipv6cidr := resourceAwsRouteSetDestination(destination); cidr != "" {
+		input.DestinationCidrBlock = aws.String(cidr)
+	} else {
+		input.DestinationIpv6CidrBlock = aws.String(ipv6cidr)
+	}
+
+	switch {
+	case d.Get("egress_only_gateway_id").(string) != "":
+		input.EgressOnlyInternetGatewayId = aws.String(d.Get("egress_only_gateway_id").(string))
+	case d.Get("gateway_id").(string) != "":
+		input.GatewayId = aws.String(d.Get("gateway_id").(string))
+	case d.Get("instance_id").(string) != "":
+		input.InstanceId = aws.String(d.Get("instance_id").(string))
+	case d.Get("local_gateway_id").(string) != "":
+		input.LocalGatewayId = aws.String(d.Get("local_gateway_id").(string))
+	case d.Get("nat_gateway_id").(string) != "":
+		input.NatGatewayId = aws.String(d.Get("nat_gateway_id").(string))
+	case d.Get("network_interface_id").(string) != "":
+		input.NetworkInterfaceId = aws.String(d.Get("network_interface_id").(string))
+	case d.Get("transit_gateway_id").(string) != "":
+		transitGatewayID, err := tfec2.TransitGatewayIDFromARNOrID(d.Get("transit_gateway_id").(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		input.TransitGatewayId = aws.String(transitGatewayID)
+	case d.Get("vpc_endpoint_id").(string) != "":
+		input.VpcEndpointId = aws.String(d.Get("vpc_endpoint_id").(string))
+	case d.Get("vpc_peering_connection_id").(string) != "":
+		input.VpcPeeringConnectionId = aws.String(d.Get("vpc_peering_connection_id").(string))
+	default:
+		return nil, fmt.Errorf("no route target specified. Specify one of the following attributes: %s", strings.Join(routeSetTargets, ", "))
+	}
+
+	return input, nil
+}
+
+// resourceAwsRouteSetCustomizeDiffTargetAddressFamily errors at plan time when the target
+// does not support the address family of one of the destinations.
+func resourceAwsRouteSetCustomizeDiffTargetAddressFamily(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("destination_cidr_blocks") {
+		return nil
+	}
+
+	for _, target := range routeSetTargets {
+		if !diff.NewValueKnown(target) || diff.Get(target).(string) == "" {
+			continue
+		}
+
+		for _, v := range diff.Get("destination_cidr_blocks").(*schema.Set).List() {
+			if err := routeTargetSupportsDestination(target, v.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsRouteSetDestination returns the destination as either an IPv4 or an IPv6 CIDR block.
+func resourceAwsRouteSetDestination(destination string) (string, string) {
+	if family, err := routeDestinationAddressFamily(destination); err == nil && family == routeAddressFamilyIpv6 {
+		return "", destination
+	}
+
+	return destination, ""
+}
+
+// resourceAwsRouteSetRouteByDestination returns the route of the route table with the specified destination, if any.
+func resourceAwsRouteSetRouteByDestination(routeTable *ec2.RouteTable, destination string) *ec2.Route {
+	cidr, ipv6cidr := resourceAwsRouteSetDestination(destination)
+
+	for _, route := range routeTable.Routes {
+		if route == nil {
+			continue
+		}
+
+		if cidr != "" && aws.StringValue(route.DestinationCidrBlock) == cidr {
+			return route
+		}
+
+		if ipv6cidr != "" && cidrBlocksEqual(aws.StringValue(route.DestinationIpv6CidrBlock), ipv6cidr) {
+			return route
+		}
+	}
+
+	return nil
+}
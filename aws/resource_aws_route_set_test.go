@@ -0,0 +1,374 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSRouteSet_basic(t *testing.T) {
+	resourceName := "aws_route_set.test"
+	igwResourceName := "aws_internet_gateway.test"
+	rtResourceName := "aws_route_table.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteSetConfigInternetGateway(rName, `"10.2.0.0/16", "10.3.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.2.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.3.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", rtResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteSetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRouteSet_disappears(t *testing.T) {
+	resourceName := "aws_route_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteSetConfigInternetGateway(rName, `"10.2.0.0/16", "10.3.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRouteSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRouteSet_UpdateDestinations(t *testing.T) {
+	resourceName := "aws_route_set.test"
+	rtResourceName := "aws_route_table.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteSetConfigInternetGateway(rName, `"10.2.0.0/16", "10.3.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSRouteSetConfigInternetGateway(rName, `"10.3.0.0/16", "10.4.0.0/16", "10.5.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					testAccCheckAWSRouteSetRouteNotExists(rtResourceName, "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.3.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.4.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.5.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRouteSet_UpdateTarget(t *testing.T) {
+	resourceName := "aws_route_set.test"
+	igwResourceName := "aws_internet_gateway.test"
+	ngwResourceName := "aws_nat_gateway.test"
+	rtResourceName := "aws_route_table.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteSetConfigUpdateTarget(rName, "gateway_id", "aws_internet_gateway.test.id", `"10.2.0.0/16", "10.3.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "nat_gateway_id", ""),
+				),
+			},
+			{
+				Config: testAccAWSRouteSetConfigUpdateTarget(rName, "nat_gateway_id", "aws_nat_gateway.test.id", `"10.3.0.0/16", "10.4.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					testAccCheckAWSRouteSetRouteNotExists(rtResourceName, "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.3.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.4.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_gateway_id", ngwResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRouteSet_IPv6(t *testing.T) {
+	resourceName := "aws_route_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteSetConfigInternetGateway(rName, `"0.0.0.0/0", "::/0"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "0.0.0.0/0"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "::/0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRouteSet_NoTarget(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRouteSetConfigNoTarget(rName),
+				ExpectError: regexp.MustCompile(`one of .+ must be specified`),
+			},
+		},
+	})
+}
+
+// testAccAWSRouteSetDestinations returns the destinations recorded in the state of the specified route set.
+func testAccAWSRouteSetDestinations(rs *terraform.ResourceState) []string {
+	var destinations []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "destination_cidr_blocks.") && k != "destination_cidr_blocks.#" {
+			destinations = append(destinations, v)
+		}
+	}
+
+	return destinations
+}
+
+func testAccCheckAWSRouteSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route_set" {
+			continue
+		}
+
+		for _, destination := range testAccAWSRouteSetDestinations(rs) {
+			cidr, ipv6cidr := resourceAwsRouteSetDestination(destination)
+
+			_, _, err := resourceAwsRouteFindRoute(conn, rs.Primary.Attributes["route_table_id"], cidr, ipv6cidr, "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Route in Route Table (%s) with destination (%s) still exists", rs.Primary.Attributes["route_table_id"], destination)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRouteSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route Set ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		for _, destination := range testAccAWSRouteSetDestinations(rs) {
+			cidr, ipv6cidr := resourceAwsRouteSetDestination(destination)
+
+			if _, _, err := resourceAwsRouteFindRoute(conn, rs.Primary.Attributes["route_table_id"], cidr, ipv6cidr, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRouteSetRouteNotExists(routeTableResourceName, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[routeTableResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", routeTableResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		cidr, ipv6cidr := resourceAwsRouteSetDestination(destination)
+
+		_, _, err := resourceAwsRouteFindRoute(conn, rs.Primary.ID, cidr, ipv6cidr, "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route in Route Table (%s) with destination (%s) still exists", rs.Primary.ID, destination)
+	}
+}
+
+func testAccAWSRouteSetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSRouteSetConfigInternetGateway(rName, destinations string) string {
+	return composeConfig(
+		testAccAWSRouteSetConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_route_set" "test" {
+  route_table_id          = aws_route_table.test.id
+  destination_cidr_blocks = [%[1]s]
+  gateway_id              = aws_internet_gateway.test.id
+}
+`, destinations))
+}
+
+func testAccAWSRouteSetConfigUpdateTarget(rName, targetAttribute, targetValue, destinations string) string {
+	return composeConfig(
+		testAccAWSRouteSetConfigBase(rName),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  allocation_id = aws_eip.test.id
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_internet_gateway.test]
+}
+
+resource "aws_route_set" "test" {
+  route_table_id          = aws_route_table.test.id
+  destination_cidr_blocks = [%[4]s]
+  %[2]s = %[3]s
+}
+`, rName, targetAttribute, targetValue, destinations))
+}
+
+func testAccAWSRouteSetConfigNoTarget(rName string) string {
+	return composeConfig(
+		testAccAWSRouteSetConfigBase(rName),
+		`
+resource "aws_route_set" "test" {
+  route_table_id          = aws_route_table.test.id
+  destination_cidr_blocks = ["10.2.0.0/16"]
+}
+`)
+}
+
+func testAccAWSRouteSetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		var destinations []string
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "destination_cidr_blocks.") && k != "destination_cidr_blocks.#" {
+				destinations = append(destinations, v)
+			}
+		}
+
+		return fmt.Sprintf("%s_%s", rs.Primary.Attributes["route_table_id"], strings.Join(destinations, ",")), nil
+	}
+}
@@ -20,6 +20,9 @@ func init() {
 	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    testSweepRouteTables,
+		Dependencies: []string{
+			"aws_route",
+		},
 	})
 }
 
@@ -1,15 +1,797 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_route", &resource.Sweeper{
+		Name: "aws_route",
+		F:    testSweepRoutes,
+	})
+}
+
+func testSweepRoutes(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).ec2conn
+
+	var sweeperErrs *multierror.Error
+
+	// Only sweep routes in route tables created by acceptance tests.
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:Name"),
+				Values: aws.StringSlice([]string{"tf-acc-test-*", "terraform-testacc-*"}),
+			},
+		},
+	}
+
+	err = conn.DescribeRouteTablesPages(input, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, routeTable := range page.RouteTables {
+			if routeTable == nil {
+				continue
+			}
+
+			id := aws.StringValue(routeTable.RouteTableId)
+
+			for _, route := range routeTable.Routes {
+				if route == nil {
+					continue
+				}
+
+				// Skip the local route and routes propagated from virtual private gateways.
+				if aws.StringValue(route.Origin) != ec2.RouteOriginCreateRoute {
+					continue
+				}
+
+				// Routes added by gateway VPC endpoints are deleted with their VPC endpoint.
+				if resourceAwsRouteDestinationIsManagedByVpcEndpoint(route) {
+					continue
+				}
+
+				input := &ec2.DeleteRouteInput{
+					RouteTableId: routeTable.RouteTableId,
+				}
+
+				if resourceAwsRouteHasPrefixListDestination(route) {
+					input.DestinationPrefixListId = route.DestinationPrefixListId
+				} else {
+					input.DestinationCidrBlock = route.DestinationCidrBlock
+					input.DestinationIpv6CidrBlock = route.DestinationIpv6CidrBlock
+				}
+
+				log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route: %s", id, input)
+				_, err := conn.DeleteRoute(input)
+
+				if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteNotFound) {
+					continue
+				}
+
+				if err != nil {
+					sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+					continue
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Route sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Route Tables: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestResourceAwsRouteDestinationIsPrefixList(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Route    *ec2.Route
+		Expected bool
+	}{
+		{
+			Name:     "nil",
+			Expected: false,
+		},
+		{
+			Name: "IPv4 CIDR block",
+			Route: &ec2.Route{
+				DestinationCidrBlock: aws.String("10.0.0.0/16"),
+				GatewayId:            aws.String("igw-12345678"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "IPv6 CIDR block",
+			Route: &ec2.Route{
+				DestinationIpv6CidrBlock:    aws.String("::/0"),
+				EgressOnlyInternetGatewayId: aws.String("eigw-12345678"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "prefix list",
+			Route: &ec2.Route{
+				DestinationPrefixListId: aws.String("pl-12345678"),
+				TransitGatewayId:        aws.String("tgw-12345678"),
+			},
+			Expected: true,
+		},
+		{
+			Name: "prefix list to VPC endpoint",
+			Route: &ec2.Route{
+				DestinationPrefixListId: aws.String("pl-12345678"),
+				GatewayId:               aws.String("vpce-12345678"),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := resourceAwsRouteDestinationIsPrefixList(testCase.Route); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceAwsRouteDestinationIsManagedByVpcEndpoint(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Route    *ec2.Route
+		Expected bool
+	}{
+		{
+			Name:     "nil",
+			Expected: false,
+		},
+		{
+			Name: "IPv4 CIDR block to VPC endpoint",
+			Route: &ec2.Route{
+				DestinationCidrBlock: aws.String("0.0.0.0/0"),
+				GatewayId:            aws.String("vpce-12345678"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "prefix list to transit gateway",
+			Route: &ec2.Route{
+				DestinationPrefixListId: aws.String("pl-12345678"),
+				TransitGatewayId:        aws.String("tgw-12345678"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "prefix list to VPC endpoint",
+			Route: &ec2.Route{
+				DestinationPrefixListId: aws.String("pl-12345678"),
+				GatewayId:               aws.String("vpce-12345678"),
+			},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := resourceAwsRouteDestinationIsManagedByVpcEndpoint(testCase.Route); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceAwsRouteParseImportID(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		ID                   string
+		ExpectedRouteTableID string
+		ExpectedDestination  string
+		ExpectError          bool
+	}{
+		{
+			Name:        "empty",
+			ID:          "",
+			ExpectError: true,
+		},
+		{
+			Name:        "no separator",
+			ID:          "rtb-12345678",
+			ExpectError: true,
+		},
+		{
+			Name:        "empty route table ID",
+			ID:          "_10.0.0.0/16",
+			ExpectError: true,
+		},
+		{
+			Name:        "empty destination",
+			ID:          "rtb-12345678_",
+			ExpectError: true,
+		},
+		{
+			Name:                 "IPv4 CIDR block",
+			ID:                   "rtb-12345678_10.0.0.0/16",
+			ExpectedRouteTableID: "rtb-12345678",
+			ExpectedDestination:  "10.0.0.0/16",
+		},
+		{
+			Name:                 "IPv6 CIDR block",
+			ID:                   "rtb-12345678_2620:0:2d0:200::8/125",
+			ExpectedRouteTableID: "rtb-12345678",
+			ExpectedDestination:  "2620:0:2d0:200::8/125",
+		},
+		{
+			Name:                 "IPv6 default route",
+			ID:                   "rtb-12345678_::/0",
+			ExpectedRouteTableID: "rtb-12345678",
+			ExpectedDestination:  "::/0",
+		},
+		{
+			Name:                 "prefix list",
+			ID:                   "rtb-12345678_pl-12345678",
+			ExpectedRouteTableID: "rtb-12345678",
+			ExpectedDestination:  "pl-12345678",
+		},
+		{
+			Name:                 "destination containing underscore",
+			ID:                   "rtb-12345678_2001:db8::_/64",
+			ExpectedRouteTableID: "rtb-12345678",
+			ExpectedDestination:  "2001:db8::_/64",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			routeTableID, destination, err := resourceAwsRouteParseImportID(testCase.ID)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if routeTableID != testCase.ExpectedRouteTableID {
+				t.Errorf("got route table ID %q, expected %q", routeTableID, testCase.ExpectedRouteTableID)
+			}
+
+			if destination != testCase.ExpectedDestination {
+				t.Errorf("got destination %q, expected %q", destination, testCase.ExpectedDestination)
+			}
+		})
+	}
+}
+
+func TestResourceAwsRouteRouteTableIDsFromID(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		ID       string
+		Expected []string
+	}{
+		{
+			Name: "empty",
+			ID:   "",
+		},
+		{
+			Name: "not a route ID",
+			ID:   "rtb-12345678_10.0.0.0/16",
+		},
+		{
+			Name:     "short route table ID",
+			ID:       "r-rtb-1234abcd12345",
+			Expected: []string{"rtb-1234abcd"},
+		},
+		{
+			Name:     "long route table ID",
+			ID:       "r-rtb-0123456789abcdef0" + "1859236582",
+			Expected: []string{"rtb-0123456789abcdef0"},
+		},
+		{
+			Name:     "ambiguous route table ID",
+			ID:       "r-rtb-12345678" + "901234567" + "1859236582",
+			Expected: []string{"rtb-12345678901234567", "rtb-12345678"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := resourceAwsRouteRouteTableIDsFromID(testCase.ID)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceAwsRouteIDForRouteTable(t *testing.T) {
+	rtbid := "rtb-12345678"
+	route := &ec2.Route{
+		DestinationCidrBlock: aws.String("10.3.0.0/16"),
+		GatewayId:            aws.String("igw-12345678"),
+	}
+
+	id := resourceAwsRouteIDForRouteTable(rtbid, route)
+
+	if expected := fmt.Sprintf("r-%s%d", rtbid, hashcode.String("10.3.0.0/16")); id != expected {
+		t.Fatalf("got %s, expected %s", id, expected)
+	}
+
+	for _, v := range resourceAwsRouteRouteTableIDsFromID(id) {
+		if v == rtbid {
+			return
+		}
+	}
+
+	t.Fatalf("route table ID %s not recovered from %s", rtbid, id)
+}
+
+func TestResourceAwsRouteChangedTarget(t *testing.T) {
+	targets := map[string]string{
+		"egress_only_gateway_id":    "eigw-12345678",
+		"gateway_id":                "igw-12345678",
+		"nat_gateway_id":            "nat-12345678",
+		"local_gateway_id":          "lgw-12345678",
+		"network_interface_id":      "eni-12345678",
+		"instance_id":               "i-12345678",
+		"transit_gateway_id":        "tgw-12345678",
+		"vpc_endpoint_id":           "vpce-12345678",
+		"vpc_peering_connection_id": "pcx-12345678",
+	}
+
+	// Switching from any target to any other only changes the newly-set target,
+	// as the previous target is Computed and keeps its prior value in the plan.
+	for from := range targets {
+		for to, value := range targets {
+			if from == to {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				got, err := resourceAwsRouteChangedTarget(map[string]string{to: value})
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got != to {
+					t.Errorf("got %s, expected %s", got, to)
+				}
+			})
+		}
+	}
+
+	t.Run("instance_id with discovered network_interface_id", func(t *testing.T) {
+		got, err := resourceAwsRouteChangedTarget(map[string]string{
+			"instance_id":          "i-12345678",
+			"network_interface_id": "eni-12345678",
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != "instance_id" {
+			t.Errorf("got %s, expected instance_id", got)
+		}
+	})
+
+	t.Run("multiple targets", func(t *testing.T) {
+		_, err := resourceAwsRouteChangedTarget(map[string]string{
+			"gateway_id":     "igw-12345678",
+			"nat_gateway_id": "nat-12345678",
+		})
+
+		if err != routeTargetValidationError {
+			t.Errorf("got %v, expected %v", err, routeTargetValidationError)
+		}
+	})
+
+	t.Run("no target", func(t *testing.T) {
+		if _, err := resourceAwsRouteChangedTarget(map[string]string{}); err == nil {
+			t.Error("expected error, got none")
+		}
+	})
+}
+
+func TestValidateRouteTableID(t *testing.T) {
+	testCases := []struct {
+		Value       string
+		ExpectError *regexp.Regexp
+	}{
+		{
+			Value: "rtb-12345678",
+		},
+		{
+			Value: "rtb-0123456789abcdef0",
+		},
+		{
+			Value:       "cvpn-endpoint-0123456789abcdef0",
+			ExpectError: regexp.MustCompile(`aws_ec2_client_vpn_route`),
+		},
+		{
+			Value:       "tgw-rtb-0123456789abcdef0",
+			ExpectError: regexp.MustCompile(`aws_ec2_transit_gateway_route`),
+		},
+		{
+			Value:       "vpc-12345678",
+			ExpectError: regexp.MustCompile(`must be a VPC route table ID`),
+		},
+		{
+			Value:       "",
+			ExpectError: regexp.MustCompile(`must be a VPC route table ID`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Value, func(t *testing.T) {
+			_, errs := validateRouteTableID(testCase.Value, "route_table_id")
+
+			if testCase.ExpectError == nil {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+
+			if len(errs) != 1 || !testCase.ExpectError.MatchString(errs[0].Error()) {
+				t.Fatalf("expected error matching %q, got %v", testCase.ExpectError, errs)
+			}
+		})
+	}
+}
+
+func TestRouteTargetSupportsDestination(t *testing.T) {
+	testCases := []struct {
+		Target      string
+		Destination string
+		ExpectError string
+	}{
+		{Target: "egress_only_gateway_id", Destination: "::/0"},
+		{Target: "egress_only_gateway_id", Destination: "0.0.0.0/0", ExpectError: "egress_only_gateway_id does not support IPv4 destinations"},
+		{Target: "gateway_id", Destination: "0.0.0.0/0"},
+		{Target: "gateway_id", Destination: "::/0"},
+		{Target: "instance_id", Destination: "10.0.0.0/16"},
+		{Target: "instance_id", Destination: "2001:db8::/32"},
+		{Target: "local_gateway_id", Destination: "10.0.0.0/16"},
+		{Target: "local_gateway_id", Destination: "2001:db8::/32", ExpectError: "local_gateway_id does not support IPv6 destinations"},
+		{Target: "nat_gateway_id", Destination: "0.0.0.0/0"},
+		{Target: "nat_gateway_id", Destination: "::/0", ExpectError: "nat_gateway_id does not support IPv6 destinations"},
+		{Target: "network_interface_id", Destination: "10.0.0.0/16"},
+		{Target: "network_interface_id", Destination: "2001:db8::/32"},
+		{Target: "transit_gateway_id", Destination: "10.0.0.0/16"},
+		{Target: "transit_gateway_id", Destination: "2001:db8::/32"},
+		{Target: "vpc_endpoint_id", Destination: "0.0.0.0/0"},
+		{Target: "vpc_endpoint_id", Destination: "::/0"},
+		{Target: "vpc_peering_connection_id", Destination: "10.0.0.0/16"},
+		{Target: "vpc_peering_connection_id", Destination: "2001:db8::/32"},
+		// Invalid destinations are reported by the destination's own validation.
+		{Target: "nat_gateway_id", Destination: "invalid"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%s %s", testCase.Target, testCase.Destination), func(t *testing.T) {
+			err := routeTargetSupportsDestination(testCase.Target, testCase.Destination)
+
+			if testCase.ExpectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.ExpectError)
+			}
+
+			if err.Error() != testCase.ExpectError {
+				t.Errorf("got error %q, expected %q", err, testCase.ExpectError)
+			}
+		})
+	}
+}
+
+func TestRouteTargetAddressFamiliesCoversTargets(t *testing.T) {
+	for _, target := range routeSetTargets {
+		if _, ok := routeTargetAddressFamilies[target]; !ok {
+			t.Errorf("no address families for route target %s", target)
+		}
+	}
+}
+
+func TestRouteOverlappingDestinations(t *testing.T) {
+	routeTable := &ec2.RouteTable{
+		Routes: []*ec2.Route{
+			nil,
+			{DestinationCidrBlock: aws.String("10.1.0.0/16"), GatewayId: aws.String("local")},
+			{DestinationIpv6CidrBlock: aws.String("2001:db8:1234:1a00::/56"), GatewayId: aws.String("local")},
+			{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-12345678")},
+			{DestinationIpv6CidrBlock: aws.String("::/0"), GatewayId: aws.String("igw-12345678")},
+			{DestinationCidrBlock: aws.String("10.3.0.0/16"), GatewayId: aws.String("igw-12345678")},
+			{DestinationCidrBlock: aws.String("10.3.1.0/24"), GatewayId: aws.String("igw-12345678")},
+			{DestinationIpv6CidrBlock: aws.String("2001:db8::/32"), EgressOnlyInternetGatewayId: aws.String("eigw-12345678")},
+			{DestinationPrefixListId: aws.String("pl-12345678"), GatewayId: aws.String("vpce-12345678")},
+		},
+	}
+
+	testCases := []struct {
+		Destination string
+		Expected    []string
+	}{
+		{
+			Destination: "10.3.1.0/24",
+			Expected:    []string{"10.3.0.0/16"},
+		},
+		{
+			Destination: "10.3.0.0/16",
+			Expected:    []string{"10.3.1.0/24"},
+		},
+		{
+			Destination: "10.0.0.0/8",
+			Expected:    []string{"10.3.0.0/16", "10.3.1.0/24"},
+		},
+		{
+			Destination: "10.1.1.0/24",
+		},
+		{
+			Destination: "0.0.0.0/0",
+		},
+		{
+			Destination: "192.168.0.0/16",
+		},
+		{
+			Destination: "2001:db8:1::/48",
+			Expected:    []string{"2001:db8::/32"},
+		},
+		{
+			Destination: "invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Destination, func(t *testing.T) {
+			got := routeOverlappingDestinations(routeTable, testCase.Destination)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRouteTableOwnerDiagnostics(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		OwnerID      string
+		AccountID    string
+		ExpectedWarn bool
+	}{
+		{
+			Name:      "same account",
+			OwnerID:   "123456789012",
+			AccountID: "123456789012",
+		},
+		{
+			Name:         "other account",
+			OwnerID:      "210987654321",
+			AccountID:    "123456789012",
+			ExpectedWarn: true,
+		},
+		{
+			Name:      "unknown caller account",
+			OwnerID:   "210987654321",
+			AccountID: "",
+		},
+		{
+			Name:      "unknown owner",
+			OwnerID:   "",
+			AccountID: "123456789012",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := routeTableOwnerDiagnostics("rtb-12345678", testCase.OwnerID, testCase.AccountID)
+
+			if !testCase.ExpectedWarn {
+				if len(diags) != 0 {
+					t.Fatalf("expected no diagnostics, got %#v", diags)
+				}
+
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %#v", diags)
+			}
+
+			if diags[0].Severity != diag.Warning {
+				t.Errorf("expected warning, got severity %v", diags[0].Severity)
+			}
+
+			if !strings.Contains(diags[0].Detail, testCase.OwnerID) {
+				t.Errorf("expected detail to contain owner (%s), got %q", testCase.OwnerID, diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestResourceAwsRouteFindRoute(t *testing.T) {
+	ec2Endpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeRouteTables&RouteTableId.1=rtb-12345678&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        testEc2DescribeRouteTablesResponsePage1,
+				ContentType: "text/xml",
+			},
+		},
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeRouteTables&NextToken=page2&RouteTableId.1=rtb-12345678&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        testEc2DescribeRouteTablesResponsePage2,
+				ContentType: "text/xml",
+			},
+		},
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeRouteTables&RouteTableId.1=rtb-87654321&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  400,
+				Body:        testEc2DescribeRouteTablesResponseNotFound,
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := ec2.New(sess)
+
+	testCases := []struct {
+		Name                      string
+		RouteTableID              string
+		Cidr                      string
+		Ipv6Cidr                  string
+		PrefixListID              string
+		ExpectedGatewayID         string
+		ExpectedRouteTableMissing bool
+	}{
+		{
+			Name:              "IPv4 CIDR block on first page",
+			RouteTableID:      "rtb-12345678",
+			Cidr:              "10.1.0.0/16",
+			ExpectedGatewayID: "local",
+		},
+		{
+			Name:              "IPv4 CIDR block on last page",
+			RouteTableID:      "rtb-12345678",
+			Cidr:              "10.3.0.0/16",
+			ExpectedGatewayID: "igw-12345678",
+		},
+		{
+			Name:              "IPv6 CIDR block on last page",
+			RouteTableID:      "rtb-12345678",
+			Ipv6Cidr:          "::/0",
+			ExpectedGatewayID: "igw-12345678",
+		},
+		{
+			Name:              "prefix list on last page",
+			RouteTableID:      "rtb-12345678",
+			PrefixListID:      "pl-12345678",
+			ExpectedGatewayID: "vpce-12345678",
+		},
+		{
+			Name:         "route not found",
+			RouteTableID: "rtb-12345678",
+			Cidr:         "10.4.0.0/16",
+		},
+		{
+			Name:         "prefix list route not found",
+			RouteTableID: "rtb-12345678",
+			PrefixListID: "pl-87654321",
+		},
+		{
+			Name:                      "route table not found",
+			RouteTableID:              "rtb-87654321",
+			Cidr:                      "10.3.0.0/16",
+			ExpectedRouteTableMissing: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			route, routeTable, err := resourceAwsRouteFindRoute(conn, testCase.RouteTableID, testCase.Cidr, testCase.Ipv6Cidr, testCase.PrefixListID)
+
+			if testCase.ExpectedGatewayID == "" {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected not found error, got: %v", err)
+				}
+
+				var nfe *resource.NotFoundError
+				if errors.As(err, &nfe) && tfawserr.ErrCodeEquals(nfe.LastError, tfec2.ErrCodeInvalidRouteTableIDNotFound) != testCase.ExpectedRouteTableMissing {
+					t.Errorf("unexpected not found error: %s", err)
+				}
+
+				if route != nil || routeTable != nil {
+					t.Fatalf("expected no route or route table, got: %s, %s", route, routeTable)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.StringValue(route.GatewayId); got != testCase.ExpectedGatewayID {
+				t.Errorf("got gateway ID %q, expected %q", got, testCase.ExpectedGatewayID)
+			}
+
+			if got := aws.StringValue(routeTable.RouteTableId); got != testCase.RouteTableID {
+				t.Errorf("got route table ID %q, expected %q", got, testCase.RouteTableID)
+			}
+		})
+	}
+}
+
 func TestAccAWSRoute_basic(t *testing.T) {
 	var route ec2.Route
 
@@ -44,6 +826,16 @@ func TestAccAWSRoute_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSRouteExists("aws_route.bar", &route),
 					testCheck,
+					testAccCheckResourceAttrAccountID("aws_route.bar", "owner_id"),
+					resource.TestCheckResourceAttr("aws_route.bar", "propagated", "false"),
+					resource.TestCheckResourceAttr("aws_route.bar", "origin", ec2.RouteOriginCreateRoute),
+					resource.TestCheckResourceAttr("aws_route.bar", "state", ec2.RouteStateActive),
+					// A gateway route has no instance, network interface or prefix list.
+					resource.TestCheckResourceAttr("aws_route.bar", "instance_id", ""),
+					resource.TestCheckResourceAttr("aws_route.bar", "instance_owner_id", ""),
+					resource.TestCheckResourceAttr("aws_route.bar", "network_interface_id", ""),
+					resource.TestCheckResourceAttr("aws_route.bar", "destination_prefix_list_id", ""),
+					resource.TestCheckResourceAttr("aws_route.bar", "destination_is_prefix_list", "false"),
 				),
 			},
 			{
@@ -78,23 +870,9 @@ func TestAccAWSRoute_disappears(t *testing.T) {
 	})
 }
 
-func TestAccAWSRoute_ipv6Support(t *testing.T) {
+func TestAccAWSRoute_ReplaceRouteNotFound(t *testing.T) {
 	var route ec2.Route
-
-	//aws creates a default route
-	testCheck := func(s *terraform.State) error {
-		name := "aws_egress_only_internet_gateway.foo"
-		gwres, ok := s.RootModule().Resources[name]
-		if !ok {
-			return fmt.Errorf("Not found: %s\n", name)
-		}
-
-		if *route.EgressOnlyInternetGatewayId != gwres.Primary.ID {
-			return fmt.Errorf("Egress Only Internet Gateway Id (Expected=%s, Actual=%s)\n", gwres.Primary.ID, *route.EgressOnlyInternetGatewayId)
-		}
-
-		return nil
-	}
+	resourceName := "aws_route.bar"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -104,29 +882,21 @@ func TestAccAWSRoute_ipv6Support(t *testing.T) {
 		CheckDestroy: testAccCheckAWSRouteDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAWSRouteConfigIpv6(),
+				Config: testAccAWSRouteBasicConfig(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSRouteExists("aws_route.bar", &route),
-					testCheck,
-					resource.TestCheckResourceAttr("aws_route.bar", "destination_ipv6_cidr_block", "::/0"),
+					testAccCheckAWSRouteExists(resourceName, &route),
+					// Simulate the route being deleted between refresh and update.
+					testAccCheckAWSRouteDeleteAndReplace(resourceName, "aws_internet_gateway.foo"),
+					testAccCheckAWSRouteExists(resourceName, &route),
 				),
 			},
-			{
-				ResourceName:      "aws_route.bar",
-				ImportState:       true,
-				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.bar"),
-				ImportStateVerify: true,
-			},
-			{
-				Config:   testAccAWSRouteConfigIpv6Expanded(),
-				PlanOnly: true,
-			},
 		},
 	})
 }
 
-func TestAccAWSRoute_ipv6ToInternetGateway(t *testing.T) {
+func TestAccAWSRoute_PollInterval(t *testing.T) {
 	var route ec2.Route
+	resourceName := "aws_route.bar"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -136,23 +906,30 @@ func TestAccAWSRoute_ipv6ToInternetGateway(t *testing.T) {
 		CheckDestroy: testAccCheckAWSRouteDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAWSRouteConfigIpv6InternetGateway(),
+				Config:      testAccAWSRouteConfigPollInterval("500ms"),
+				ExpectError: regexp.MustCompile(`must be between`),
+			},
+			{
+				Config: testAccAWSRouteConfigPollInterval("2s"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSRouteExists("aws_route.igw", &route),
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "2s"),
 				),
 			},
 			{
-				ResourceName:      "aws_route.igw",
-				ImportState:       true,
-				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.igw"),
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"poll_interval"},
 			},
 		},
 	})
 }
 
-func TestAccAWSRoute_ipv6ToInstance(t *testing.T) {
+func TestAccAWSRoute_AllowDestinationChangeInPlace(t *testing.T) {
 	var route ec2.Route
+	resourceName := "aws_route.bar"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -162,26 +939,40 @@ func TestAccAWSRoute_ipv6ToInstance(t *testing.T) {
 		CheckDestroy: testAccCheckAWSRouteDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAWSRouteConfigIpv6Instance(),
+				Config: testAccAWSRouteConfigAllowDestinationChangeInPlace("10.3.0.0/16"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSRouteExists("aws_route.internal-default-route-ipv6", &route),
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "allow_destination_change_in_place", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.3.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test"),
 				),
 			},
 			{
-				ResourceName:      "aws_route.internal-default-route-ipv6",
-				ImportState:       true,
-				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.internal-default-route-ipv6"),
-				ImportStateVerify: true,
+				Config: testAccAWSRouteConfigAllowDestinationChangeInPlace("10.4.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					testAccCheckAWSRouteDestinationNotExists("aws_route_table.foo", "10.3.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.4.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", "aws_internet_gateway.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test"),
+				),
 			},
 			{
-				Config:   testAccAWSRouteConfigIpv6InstanceExpanded(),
-				PlanOnly: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_destination_change_in_place"},
 			},
 		},
 	})
 }
 
-func TestAccAWSRoute_ipv6ToNetworkInterface(t *testing.T) {
+func TestAccAWSRoute_disappears_RouteTable(t *testing.T) {
 	var route ec2.Route
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -192,26 +983,153 @@ func TestAccAWSRoute_ipv6ToNetworkInterface(t *testing.T) {
 		CheckDestroy: testAccCheckAWSRouteDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAWSRouteConfigIpv6NetworkInterface(),
+				Config: testAccAWSRouteBasicConfig(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSRouteExists("aws_route.internal-default-route-ipv6", &route),
+					testAccCheckAWSRouteExists("aws_route.bar", &route),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRouteTable(), "aws_route_table.foo"),
 				),
-			},
-			{
-				ResourceName:      "aws_route.internal-default-route-ipv6",
-				ImportState:       true,
-				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.internal-default-route-ipv6"),
-				ImportStateVerify: true,
+				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func TestAccAWSRoute_ipv6ToPeeringConnection(t *testing.T) {
+func TestAccAWSRoute_ipv6Support(t *testing.T) {
 	var route ec2.Route
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
+	//aws creates a default route
+	testCheck := func(s *terraform.State) error {
+		name := "aws_egress_only_internet_gateway.foo"
+		gwres, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", name)
+		}
+
+		if *route.EgressOnlyInternetGatewayId != gwres.Primary.ID {
+			return fmt.Errorf("Egress Only Internet Gateway Id (Expected=%s, Actual=%s)\n", gwres.Primary.ID, *route.EgressOnlyInternetGatewayId)
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigIpv6(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists("aws_route.bar", &route),
+					testCheck,
+					resource.TestCheckResourceAttr("aws_route.bar", "destination_ipv6_cidr_block", "::/0"),
+				),
+			},
+			{
+				ResourceName:      "aws_route.bar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.bar"),
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccAWSRouteConfigIpv6Expanded(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_ipv6ToInternetGateway(t *testing.T) {
+	var route ec2.Route
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigIpv6InternetGateway(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists("aws_route.igw", &route),
+				),
+			},
+			{
+				ResourceName:      "aws_route.igw",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.igw"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_ipv6ToInstance(t *testing.T) {
+	var route ec2.Route
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigIpv6Instance(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists("aws_route.internal-default-route-ipv6", &route),
+					resource.TestCheckResourceAttr("aws_route.internal-default-route-ipv6", "state", ec2.RouteStateActive),
+					resource.TestCheckResourceAttrPair("aws_route.internal-default-route-ipv6", "network_interface_id", "aws_instance.test-router", "primary_network_interface_id"),
+				),
+			},
+			{
+				ResourceName:      "aws_route.internal-default-route-ipv6",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.internal-default-route-ipv6"),
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccAWSRouteConfigIpv6InstanceExpanded(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_ipv6ToNetworkInterface(t *testing.T) {
+	var route ec2.Route
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigIpv6NetworkInterface(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists("aws_route.internal-default-route-ipv6", &route),
+				),
+			},
+			{
+				ResourceName:      "aws_route.internal-default-route-ipv6",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.internal-default-route-ipv6"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_ipv6ToPeeringConnection(t *testing.T) {
+	var route ec2.Route
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
 			testAccPreCheck(t)
 		},
 		Providers:    testAccProviders,
@@ -438,6 +1356,61 @@ func TestAccAWSRoute_TransitGatewayID_DestinationCidrBlock(t *testing.T) {
 	})
 }
 
+func TestAccAWSRoute_TransitGatewayARN(t *testing.T) {
+	var route ec2.Route
+	resourceName := "aws_route.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigTransitGatewayARN(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_TransitGatewayARN_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRouteConfigTransitGatewayARNInvalid(),
+				ExpectError: regexp.MustCompile(`expected resource prefix transit-gateway`),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_EmptyTarget(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRouteConfigEmptyTarget(),
+				ExpectError: regexp.MustCompile(`no route target specified`),
+			},
+		},
+	})
+}
+
 func TestAccAWSRoute_LocalGatewayID(t *testing.T) {
 	var route ec2.Route
 	resourceName := "aws_route.test"
@@ -481,6 +1454,7 @@ func TestAccAWSRoute_ConditionalCidrBlock(t *testing.T) {
 					testAccCheckAWSRouteExists(resourceName, &route),
 					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "0.0.0.0/0"),
 					resource.TestCheckResourceAttr(resourceName, "destination_ipv6_cidr_block", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_is_prefix_list", "false"),
 				),
 			},
 			{
@@ -529,78 +1503,1386 @@ func TestAccAWSRoute_VpcEndpointId(t *testing.T) {
 	})
 }
 
-func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s\n", n)
-		}
+func TestAccAWSRoute_WarnOnOverlappingRoutes(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No ID is set")
-		}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigWarnOnOverlappingRoutes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.3.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "warn_on_overlapping_routes", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"warn_on_overlapping_routes"},
+			},
+		},
+	})
+}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
-		r, err := resourceAwsRouteFindRoute(
-			conn,
-			rs.Primary.Attributes["route_table_id"],
-			rs.Primary.Attributes["destination_cidr_block"],
-			rs.Primary.Attributes["destination_ipv6_cidr_block"],
-		)
+func TestAccAWSRoute_Description(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	rtResourceName := "aws_route_table.test"
 
-		if err != nil {
-			return err
-		}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigDescription(rName, "10.3.0.0/16", "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(rtResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr("data.aws_route_table.test", "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSRouteConfigDescription(rName, "10.3.0.0/16", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+			{
+				// The description moves along with the route.
+				Config: testAccAWSRouteConfigDescription(rName, "10.4.0.0/16", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.4.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					testAccCheckAWSRouteTableTagCount(rtResourceName, 2),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigDescription(rName, "10.3.0.0/16", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					testAccCheckAWSRouteTableTagCount(rtResourceName, 1),
+				),
+			},
+		},
+	})
+}
 
-		if r == nil {
-			return fmt.Errorf("Route not found")
-		}
+func TestAccAWSRoute_Tags(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	rtResourceName := "aws_route_table.test"
 
-		*res = *r
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(rtResourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSRouteConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_InstanceID_NotFound(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRouteConfigInstanceIDNotFound(rName),
+				ExpectError: regexp.MustCompile(`EC2 Instance \(i-0123456789abcdef0\) not found`),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_CreateBeforeDestroy_DestinationChange(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	rtResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigCreateBeforeDestroy(rName, "10.3.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.3.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+				),
+			},
+			{
+				// The replacement route is created before the route being replaced is deleted.
+				Config: testAccAWSRouteConfigCreateBeforeDestroy(rName, "10.4.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.4.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+					testAccCheckAWSRouteDestinationNotExists(rtResourceName, "10.3.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_UpdateTargetType(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	igwResourceName := "aws_internet_gateway.test"
+	natResourceName := "aws_nat_gateway.test"
+	eniResourceName := "aws_network_interface.test"
+	pcxResourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigUpdateTargetType(rName, "gateway_id = aws_internet_gateway.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigUpdateTargetType(rName, "nat_gateway_id = aws_nat_gateway.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "gateway_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_gateway_id", natResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigUpdateTargetType(rName, "network_interface_id = aws_network_interface.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "nat_gateway_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eniResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigUpdateTargetType(rName, "vpc_peering_connection_id = aws_vpc_peering_connection.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", pcxResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigUpdateTargetType(rName, "gateway_id = aws_internet_gateway.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_peering_connection_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_owner_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_UpdateTargetType_Ipv6(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	igwResourceName := "aws_internet_gateway.test"
+	eigwResourceName := "aws_egress_only_internet_gateway.test"
+	eniResourceName := "aws_network_interface.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigUpdateTargetTypeIpv6(rName, "gateway_id = aws_internet_gateway.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_ipv6_cidr_block", "::/0"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigUpdateTargetTypeIpv6(rName, "egress_only_gateway_id = aws_egress_only_internet_gateway.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_ipv6_cidr_block", "::/0"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "egress_only_gateway_id", eigwResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigUpdateTargetTypeIpv6(rName, "network_interface_id = aws_network_interface.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_ipv6_cidr_block", "::/0"),
+					resource.TestCheckResourceAttr(resourceName, "egress_only_gateway_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eniResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_GatewayID(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	igwResourceName := "aws_internet_gateway.test"
+	vgwResourceName := "aws_vpn_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigGatewayID(rName, igwResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigGatewayID(rName, vgwResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", vgwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_EgressOnlyGatewayID(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	eigwResourceName := "aws_egress_only_internet_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigEgressOnlyGatewayID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "egress_only_gateway_id", eigwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ipv6_cidr_block", "::/0"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_NatGatewayID(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	nat1ResourceName := "aws_nat_gateway.test1"
+	nat2ResourceName := "aws_nat_gateway.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigNatGatewayID(rName, nat1ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_gateway_id", nat1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigNatGatewayID(rName, nat2ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_gateway_id", nat2ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_InstanceID(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	instance1ResourceName := "aws_instance.test1"
+	instance2ResourceName := "aws_instance.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigInstanceID(rName, instance1ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", instance1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", instance1ResourceName, "primary_network_interface_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "instance_owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigInstanceID(rName, instance2ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", instance2ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", instance2ResourceName, "primary_network_interface_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_NetworkInterfaceID(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	eni1ResourceName := "aws_network_interface.test1"
+	eni2ResourceName := "aws_network_interface.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigNetworkInterfaceID(rName, eni1ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eni1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", ""),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigNetworkInterfaceID(rName, eni2ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eni2ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_VpcPeeringConnectionID(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	pcx1ResourceName := "aws_vpc_peering_connection.test1"
+	pcx2ResourceName := "aws_vpc_peering_connection.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigVpcPeeringConnectionID(rName, pcx1ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", pcx1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				Config: testAccAWSRouteConfigVpcPeeringConnectionID(rName, pcx2ResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", pcx2ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute_AdoptExisting(t *testing.T) {
+	var route ec2.Route
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route.test"
+	igwResourceName := "aws_internet_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigAdoptExistingBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteCreateOutOfBand("aws_route_table.test", "10.3.0.0/16", igwResourceName),
+				),
+			},
+			{
+				Config:      testAccAWSRouteConfigAdoptExisting(rName, false),
+				ExpectError: regexp.MustCompile(`RouteAlreadyExists`),
+			},
+			{
+				Config: testAccAWSRouteConfigAdoptExisting(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "origin", ec2.RouteOriginCreateRoute),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSRouteImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		r, _, err := resourceAwsRouteFindRoute(
+			conn,
+			rs.Primary.Attributes["route_table_id"],
+			rs.Primary.Attributes["destination_cidr_block"],
+			rs.Primary.Attributes["destination_ipv6_cidr_block"],
+			rs.Primary.Attributes["destination_prefix_list_id"],
+		)
+
+		if err != nil {
+			return err
+		}
+
+		*res = *r
+
+		return nil
+	}
+}
+
+// testAccCheckAWSRouteDeleteAndReplace deletes the route outside of Terraform and then points it at
+// the specified gateway, exercising the CreateRoute fallback of a ReplaceRoute on a missing route.
+func testAccCheckAWSRouteDeleteAndReplace(n, gatewayResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", n)
+		}
+
+		gw, ok := s.RootModule().Resources[gatewayResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", gatewayResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.DeleteRoute(&ec2.DeleteRouteInput{
+			RouteTableId:         aws.String(rs.Primary.Attributes["route_table_id"]),
+			DestinationCidrBlock: aws.String(rs.Primary.Attributes["destination_cidr_block"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return resourceAwsRouteReplaceRoute(conn, &ec2.ReplaceRouteInput{
+			RouteTableId:         aws.String(rs.Primary.Attributes["route_table_id"]),
+			DestinationCidrBlock: aws.String(rs.Primary.Attributes["destination_cidr_block"]),
+			GatewayId:            aws.String(gw.Primary.ID),
+		}, 2*time.Minute, routeRetryMinInterval, routeRetryMaxInterval)
+	}
+}
+
+// testAccCheckAWSRouteCreateOutOfBand creates a route to the specified gateway outside of Terraform.
+func testAccCheckAWSRouteCreateOutOfBand(routeTableResourceName, cidr, gatewayResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[routeTableResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", routeTableResourceName)
+		}
+
+		gw, ok := s.RootModule().Resources[gatewayResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", gatewayResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.CreateRoute(&ec2.CreateRouteInput{
+			RouteTableId:         aws.String(rs.Primary.ID),
+			DestinationCidrBlock: aws.String(cidr),
+			GatewayId:            aws.String(gw.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+// testAccCheckAWSRouteDestinationNotExists checks that the route table has no route with the specified IPv4 destination.
+func testAccCheckAWSRouteDestinationNotExists(routeTableResourceName, cidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[routeTableResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s\n", routeTableResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, _, err := resourceAwsRouteFindRoute(conn, rs.Primary.ID, cidr, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route in Route Table (%s) with destination (%s) still exists", rs.Primary.ID, cidr)
+	}
+}
+
+// testAccCheckAWSRouteTableTagCount checks the number of tags on the route table, including those holding
+// route descriptions and tags, which the route table resource does not report.
+func testAccCheckAWSRouteTableTagCount(routeTableResourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var routeTable ec2.RouteTable
+
+		if err := testAccCheckRouteTableExists(routeTableResourceName, &routeTable)(s); err != nil {
+			return err
+		}
+
+		if got := len(routeTable.Tags); got != expected {
+			return fmt.Errorf("Route Table (%s) has %d tags, expected %d", aws.StringValue(routeTable.RouteTableId), got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRouteDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		_, _, err := resourceAwsRouteFindRoute(
+			conn,
+			rs.Primary.Attributes["route_table_id"],
+			rs.Primary.Attributes["destination_cidr_block"],
+			rs.Primary.Attributes["destination_ipv6_cidr_block"],
+			rs.Primary.Attributes["destination_prefix_list_id"],
+		)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSRouteImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		destination := rs.Primary.Attributes["destination_cidr_block"]
+		if v, ok := rs.Primary.Attributes["destination_ipv6_cidr_block"]; ok && v != "" {
+			destination = v
+		}
+
+		return fmt.Sprintf("%s_%s", rs.Primary.Attributes["route_table_id"], destination), nil
+	}
+}
+
+func testAccAWSRouteBasicConfig() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-basic"
+  }
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-basic"
+  }
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id         = aws_route_table.foo.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.foo.id
+}
+`
+}
+
+func testAccAWSRouteConfigPollInterval(pollInterval string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-poll-interval"
+  }
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-poll-interval"
+  }
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id         = aws_route_table.foo.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.foo.id
+  poll_interval          = %[1]q
+}
+`, pollInterval)
+}
+
+func testAccAWSRouteConfigAllowDestinationChangeInPlace(destinationCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-destination-change"
+  }
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-destination-change"
+  }
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id                    = aws_route_table.foo.id
+  destination_cidr_block            = %[1]q
+  gateway_id                        = aws_internet_gateway.foo.id
+  allow_destination_change_in_place = true
+  description                       = "test"
+
+  tags = {
+    Name = "test"
+  }
+}
+`, destinationCidr)
+}
+
+func testAccAWSRouteConfigIpv6InternetGateway() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-igw"
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-igw"
+  }
+}
+
+resource "aws_route_table" "external" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "igw" {
+  route_table_id              = aws_route_table.external.id
+  destination_ipv6_cidr_block = "::/0"
+  gateway_id                  = aws_internet_gateway.foo.id
+}
+`
+}
+
+func testAccAWSRouteConfigIpv6NetworkInterface() string {
+	return composeConfig(
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.router-network.availability_zone", "t2.small", "t3.small"),
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
+resource "aws_vpc" "examplevpc" {
+  cidr_block                       = "10.100.0.0/16"
+  enable_dns_hostnames             = true
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-network-interface"
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_internet_gateway" "internet" {
+  vpc_id = aws_vpc.examplevpc.id
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-network-interface"
+  }
+}
+
+resource "aws_route" "igw" {
+  route_table_id         = aws_vpc.examplevpc.main_route_table_id
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = aws_internet_gateway.internet.id
+}
+
+resource "aws_route" "igw-ipv6" {
+  route_table_id              = aws_vpc.examplevpc.main_route_table_id
+  destination_ipv6_cidr_block = "::/0"
+  gateway_id                  = aws_internet_gateway.internet.id
+}
+
+resource "aws_subnet" "router-network" {
+  cidr_block                      = "10.100.1.0/24"
+  vpc_id                          = aws_vpc.examplevpc.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  map_public_ip_on_launch         = true
+  availability_zone               = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = "tf-acc-route-ipv6-network-interface-router"
+  }
+}
+
+resource "aws_subnet" "client-network" {
+  cidr_block                      = "10.100.10.0/24"
+  vpc_id                          = aws_vpc.examplevpc.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 2)
+  assign_ipv6_address_on_creation = true
+  map_public_ip_on_launch         = false
+  availability_zone               = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = "tf-acc-route-ipv6-network-interface-client"
+  }
+}
+
+resource "aws_route_table" "client-routes" {
+  vpc_id = aws_vpc.examplevpc.id
+}
+
+resource "aws_route_table_association" "client-routes" {
+  route_table_id = aws_route_table.client-routes.id
+  subnet_id      = aws_subnet.client-network.id
+}
+
+resource "aws_instance" "test-router" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.router-network.id
+}
+
+resource "aws_network_interface" "router-internal" {
+  subnet_id         = aws_subnet.client-network.id
+  source_dest_check = false
+}
+
+resource "aws_network_interface_attachment" "router-internal" {
+  device_index         = 1
+  instance_id          = aws_instance.test-router.id
+  network_interface_id = aws_network_interface.router-internal.id
+}
+
+resource "aws_route" "internal-default-route" {
+  route_table_id         = aws_route_table.client-routes.id
+  destination_cidr_block = "0.0.0.0/0"
+  network_interface_id   = aws_network_interface.router-internal.id
+}
+
+resource "aws_route" "internal-default-route-ipv6" {
+  route_table_id              = aws_route_table.client-routes.id
+  destination_ipv6_cidr_block = "::/0"
+  network_interface_id        = aws_network_interface.router-internal.id
+}
+`)
+}
+
+func testAccAWSRouteConfigIpv6Instance() string {
+	return composeConfig(
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.router-network.availability_zone", "t2.small", "t3.small"),
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
+resource "aws_vpc" "examplevpc" {
+  cidr_block                       = "10.100.0.0/16"
+  enable_dns_hostnames             = true
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-instance"
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_internet_gateway" "internet" {
+  vpc_id = aws_vpc.examplevpc.id
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-instance"
+  }
+}
+
+resource "aws_route" "igw" {
+  route_table_id         = aws_vpc.examplevpc.main_route_table_id
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = aws_internet_gateway.internet.id
+}
+
+resource "aws_route" "igw-ipv6" {
+  route_table_id              = aws_vpc.examplevpc.main_route_table_id
+  destination_ipv6_cidr_block = "::/0"
+  gateway_id                  = aws_internet_gateway.internet.id
+}
+
+resource "aws_subnet" "router-network" {
+  cidr_block                      = "10.100.1.0/24"
+  vpc_id                          = aws_vpc.examplevpc.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  map_public_ip_on_launch         = true
+  availability_zone               = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = "tf-acc-route-ipv6-instance-router"
+  }
+}
+
+resource "aws_subnet" "client-network" {
+  cidr_block                      = "10.100.10.0/24"
+  vpc_id                          = aws_vpc.examplevpc.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 2)
+  assign_ipv6_address_on_creation = true
+  map_public_ip_on_launch         = false
+  availability_zone               = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = "tf-acc-route-ipv6-instance-client"
+  }
+}
+
+resource "aws_route_table" "client-routes" {
+  vpc_id = aws_vpc.examplevpc.id
+}
+
+resource "aws_route_table_association" "client-routes" {
+  route_table_id = aws_route_table.client-routes.id
+  subnet_id      = aws_subnet.client-network.id
+}
+
+resource "aws_instance" "test-router" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.router-network.id
+}
+
+resource "aws_route" "internal-default-route" {
+  route_table_id         = aws_route_table.client-routes.id
+  destination_cidr_block = "0.0.0.0/0"
+  instance_id            = aws_instance.test-router.id
+}
+
+resource "aws_route" "internal-default-route-ipv6" {
+  route_table_id              = aws_route_table.client-routes.id
+  destination_ipv6_cidr_block = "::/0"
+  instance_id                 = aws_instance.test-router.id
+}
+`)
+}
+
+func testAccAWSRouteConfigIpv6InstanceExpanded() string {
+	return composeConfig(
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.router-network.availability_zone", "t2.small", "t3.small"),
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
+resource "aws_vpc" "examplevpc" {
+  cidr_block                       = "10.100.0.0/16"
+  enable_dns_hostnames             = true
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-instance"
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_internet_gateway" "internet" {
+  vpc_id = aws_vpc.examplevpc.id
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-instance"
+  }
+}
+
+resource "aws_route" "igw" {
+  route_table_id         = aws_vpc.examplevpc.main_route_table_id
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = aws_internet_gateway.internet.id
+}
+
+resource "aws_route" "igw-ipv6" {
+  route_table_id              = aws_vpc.examplevpc.main_route_table_id
+  destination_ipv6_cidr_block = "::0/0"
+  gateway_id                  = aws_internet_gateway.internet.id
+}
+
+resource "aws_subnet" "router-network" {
+  cidr_block                      = "10.100.1.0/24"
+  vpc_id                          = aws_vpc.examplevpc.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  map_public_ip_on_launch         = true
+  availability_zone               = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = "tf-acc-route-ipv6-instance-router"
+  }
+}
+
+resource "aws_subnet" "client-network" {
+  cidr_block                      = "10.100.10.0/24"
+  vpc_id                          = aws_vpc.examplevpc.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 2)
+  assign_ipv6_address_on_creation = true
+  map_public_ip_on_launch         = false
+  availability_zone               = data.aws_availability_zones.available.names[0]
 
-		return nil
-	}
+  tags = {
+    Name = "tf-acc-route-ipv6-instance-client"
+  }
 }
 
-func testAccCheckAWSRouteDestroy(s *terraform.State) error {
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_route" {
-			continue
-		}
+resource "aws_route_table" "client-routes" {
+  vpc_id = aws_vpc.examplevpc.id
+}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
-		route, err := resourceAwsRouteFindRoute(
-			conn,
-			rs.Primary.Attributes["route_table_id"],
-			rs.Primary.Attributes["destination_cidr_block"],
-			rs.Primary.Attributes["destination_ipv6_cidr_block"],
-		)
+resource "aws_route_table_association" "client-routes" {
+  route_table_id = aws_route_table.client-routes.id
+  subnet_id      = aws_subnet.client-network.id
+}
 
-		if route == nil && err == nil {
-			return nil
-		}
-	}
+resource "aws_instance" "test-router" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.router-network.id
+}
 
-	return nil
+resource "aws_route" "internal-default-route" {
+  route_table_id         = aws_route_table.client-routes.id
+  destination_cidr_block = "0.0.0.0/0"
+  instance_id            = aws_instance.test-router.id
 }
 
-func testAccAWSRouteImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
-	return func(s *terraform.State) (string, error) {
-		rs, ok := s.RootModule().Resources[resourceName]
-		if !ok {
-			return "", fmt.Errorf("not found: %s", resourceName)
-		}
+resource "aws_route" "internal-default-route-ipv6" {
+  route_table_id              = aws_route_table.client-routes.id
+  destination_ipv6_cidr_block = "::0/0"
+  instance_id                 = aws_instance.test-router.id
+}
+`)
+}
 
-		destination := rs.Primary.Attributes["destination_cidr_block"]
-		if v, ok := rs.Primary.Attributes["destination_ipv6_cidr_block"]; ok && v != "" {
-			destination = v
-		}
+func testAccAWSRouteConfigIpv6PeeringConnection() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6-peering-connection"
+  }
+}
+
+resource "aws_vpc" "bar" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+}
+
+resource "aws_vpc_peering_connection" "foo" {
+  vpc_id      = aws_vpc.foo.id
+  peer_vpc_id = aws_vpc.bar.id
+  auto_accept = true
+}
+
+resource "aws_route_table" "peering" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "pc" {
+  route_table_id              = aws_route_table.peering.id
+  destination_ipv6_cidr_block = aws_vpc.bar.ipv6_cidr_block
+  vpc_peering_connection_id   = aws_vpc_peering_connection.foo.id
+}
+`
+}
+
+func testAccAWSRouteConfigIpv6() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6"
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id              = aws_route_table.foo.id
+  destination_ipv6_cidr_block = "::/0"
+  egress_only_gateway_id      = aws_egress_only_internet_gateway.foo.id
+}
+`
+}
+
+func testAccAWSRouteConfigIpv6Expanded() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-route-ipv6"
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id              = aws_route_table.foo.id
+  destination_ipv6_cidr_block = "::0/0"
+  egress_only_gateway_id      = aws_egress_only_internet_gateway.foo.id
+}
+`
+}
+
+func testAccAWSRouteBasicConfigChangeCidr() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-change-cidr"
+  }
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-change-cidr"
+  }
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id         = aws_route_table.foo.id
+  destination_cidr_block = "10.2.0.0/16"
+  gateway_id             = aws_internet_gateway.foo.id
+}
+`
+}
+
+func testAccAWSRouteNoopChange() string {
+	return composeConfig(
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "t2.nano", "t3.nano"),
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-noop-change"
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "10.10.10.0/24"
+
+  tags = {
+    Name = "tf-acc-route-noop-change"
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "0.0.0.0/0"
+  instance_id            = aws_instance.nat.id
+}
+
+resource "aws_instance" "nat" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+}
+`)
+}
+
+func testAccAWSRouteWithVPCEndpoint() string {
+	return `
+data "aws_region" "current" {}
+
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-with-vpc-endpoint"
+  }
+}
+
+resource "aws_internet_gateway" "foo" {
+  vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-with-vpc-endpoint"
+  }
+}
+
+resource "aws_route_table" "foo" {
+  vpc_id = aws_vpc.foo.id
+}
+
+resource "aws_route" "bar" {
+  route_table_id         = aws_route_table.foo.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.foo.id
+
+  # Forcing endpoint to create before route - without this the crash is a race.
+  depends_on = [aws_vpc_endpoint.baz]
+}
 
-		return fmt.Sprintf("%s_%s", rs.Primary.Attributes["route_table_id"], destination), nil
-	}
+resource "aws_vpc_endpoint" "baz" {
+  vpc_id          = aws_vpc.foo.id
+  service_name    = "com.amazonaws.${data.aws_region.current.name}.s3"
+  route_table_ids = [aws_route_table.foo.id]
+}
+`
 }
 
-func testAccAWSRouteBasicConfig() string {
+func testAccAWSRouteNewRouteTable() string {
 	return `
 resource "aws_vpc" "foo" {
   cidr_block = "10.1.0.0/16"
@@ -610,6 +2892,14 @@ resource "aws_vpc" "foo" {
   }
 }
 
+resource "aws_vpc" "bar" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-route-new-route-table"
+  }
+}
+
 resource "aws_internet_gateway" "foo" {
   vpc_id = aws_vpc.foo.id
 
@@ -618,676 +2908,910 @@ resource "aws_internet_gateway" "foo" {
   }
 }
 
+resource "aws_internet_gateway" "bar" {
+  vpc_id = aws_vpc.bar.id
+
+  tags = {
+    Name = "terraform-testacc-route-new-route-table"
+  }
+}
+
 resource "aws_route_table" "foo" {
   vpc_id = aws_vpc.foo.id
+
+  tags = {
+    Name = "terraform-testacc-route-basic"
+  }
+}
+
+resource "aws_route_table" "bar" {
+  vpc_id = aws_vpc.bar.id
+
+  tags = {
+    Name = "terraform-testacc-route-new-route-table"
+  }
 }
 
 resource "aws_route" "bar" {
-  route_table_id         = aws_route_table.foo.id
-  destination_cidr_block = "10.3.0.0/16"
-  gateway_id             = aws_internet_gateway.foo.id
+  route_table_id         = aws_route_table.bar.id
+  destination_cidr_block = "10.4.0.0/16"
+  gateway_id             = aws_internet_gateway.bar.id
 }
 `
 }
 
-func testAccAWSRouteConfigIpv6InternetGateway() string {
+func testAccAWSRouteConfigTransitGatewayIDDestinatationCidrBlock() string {
+	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(),
+		`
+# IncorrectState: Transit Gateway is not available in availability zone usw2-az4	
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-ec2-route-transit-gateway-id"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-ec2-route-transit-gateway-id"
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+
+resource "aws_route" "test" {
+  destination_cidr_block = "0.0.0.0/0"
+  route_table_id         = aws_vpc.test.default_route_table_id
+  transit_gateway_id     = aws_ec2_transit_gateway_vpc_attachment.test.transit_gateway_id
+}
+`)
+}
+
+func testAccAWSRouteConfigTransitGatewayARN() string {
+	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(),
+		`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-ec2-route-transit-gateway-arn"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-ec2-route-transit-gateway-arn"
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+
+resource "aws_route" "test" {
+  destination_cidr_block = "0.0.0.0/0"
+  route_table_id         = aws_vpc.test.default_route_table_id
+  transit_gateway_id     = aws_ec2_transit_gateway.test.arn
+
+  depends_on = [aws_ec2_transit_gateway_vpc_attachment.test]
+}
+`)
+}
+
+func testAccAWSRouteConfigTransitGatewayARNInvalid() string {
 	return `
-resource "aws_vpc" "foo" {
+resource "aws_route" "test" {
+  destination_cidr_block = "0.0.0.0/0"
+  route_table_id         = "rtb-12345678"
+  transit_gateway_id     = "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"
+}
+`
+}
+
+func testAccAWSRouteConfigEmptyTarget() string {
+	return `
+variable "gateway_id" {
+  default = ""
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-ec2-route-empty-target"
+  }
+}
+
+resource "aws_route" "test" {
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = var.gateway_id
+  route_table_id         = aws_vpc.test.default_route_table_id
+}
+`
+}
+
+func testAccAWSRouteConfigConditionalIpv4Ipv6(rName string, ipv6Route bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
   cidr_block                       = "10.1.0.0/16"
   assign_generated_ipv6_cidr_block = true
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-igw"
+    Name = %[1]q
   }
 }
 
-resource "aws_egress_only_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+locals {
+  ipv6             = %[2]t
+  destination      = "0.0.0.0/0"
+  destination_ipv6 = "::/0"
+}
+
+resource "aws_route" "test" {
+  route_table_id = aws_route_table.test.id
+  gateway_id     = aws_internet_gateway.test.id
+
+  destination_cidr_block      = local.ipv6 ? "" : local.destination
+  destination_ipv6_cidr_block = local.ipv6 ? local.destination_ipv6 : ""
+}
+`, rName, ipv6Route)
+}
+
+func testAccAWSRouteResourceConfigLocalGatewayID() string {
+	return `
+data "aws_ec2_local_gateways" "all" {}
+data "aws_ec2_local_gateway" "first" {
+  id = tolist(data.aws_ec2_local_gateways.all.ids)[0]
+}
+
+data "aws_ec2_local_gateway_route_tables" "all" {}
+data "aws_ec2_local_gateway_route_table" "first" {
+  local_gateway_route_table_id = tolist(data.aws_ec2_local_gateway_route_tables.all.ids)[0]
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_ec2_local_gateway_route_table_vpc_association" "example" {
+  local_gateway_route_table_id = data.aws_ec2_local_gateway_route_table.first.id
+  vpc_id                       = aws_vpc.test.id
+}
+
+resource "aws_route_table" "test" {
+  vpc_id     = aws_vpc.test.id
+  depends_on = [aws_ec2_local_gateway_route_table_vpc_association.example]
+}
+
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "172.16.1.0/24"
+  local_gateway_id       = data.aws_ec2_local_gateway.first.id
+}
+`
+}
+
+func testAccAWSRouteResourceConfigVpcEndpointId(rName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.10.0/25"
+
+  tags = {
+    Name = "tf-acc-test-load-balancer"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 2, 0)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-load-balancer"
+  }
+}
+
+resource "aws_lb" "test" {
+  load_balancer_type = "gateway"
+  name               = %[1]q
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test.id
+  }
 }
 
-resource "aws_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_vpc_endpoint_service" "test" {
+  acceptance_required        = false
+  allowed_principals         = [data.aws_caller_identity.current.arn]
+  gateway_load_balancer_arns = [aws_lb.test.arn]
+}
 
-  tags = {
-    Name = "terraform-testacc-route-ipv6-igw"
-  }
+resource "aws_vpc_endpoint" "test" {
+  service_name      = aws_vpc_endpoint_service.test.service_name
+  subnet_ids        = [aws_subnet.test.id]
+  vpc_endpoint_type = aws_vpc_endpoint_service.test.service_type
+  vpc_id            = aws_vpc.test.id
 }
 
-resource "aws_route_table" "external" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 }
 
-resource "aws_route" "igw" {
-  route_table_id              = aws_route_table.external.id
-  destination_ipv6_cidr_block = "::/0"
-  gateway_id                  = aws_internet_gateway.foo.id
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "172.16.1.0/24"
+  vpc_endpoint_id        = aws_vpc_endpoint.test.id
 }
-`
+`, rName))
 }
 
-func testAccAWSRouteConfigIpv6NetworkInterface() string {
-	return composeConfig(
-		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.router-network.availability_zone", "t2.small", "t3.small"),
-		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
-resource "aws_vpc" "examplevpc" {
-  cidr_block                       = "10.100.0.0/16"
-  enable_dns_hostnames             = true
-  assign_generated_ipv6_cidr_block = true
+func testAccAWSRouteConfigWarnOnOverlappingRoutes(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-network-interface"
+    Name = %[1]q
   }
 }
 
-data "aws_availability_zones" "available" {
-  state = "available"
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
-  filter {
-    name   = "opt-in-status"
-    values = ["opt-in-not-required"]
+  tags = {
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "internet" {
-  vpc_id = aws_vpc.examplevpc.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-network-interface"
+    Name = %[1]q
   }
 }
 
-resource "aws_route" "igw" {
-  route_table_id         = aws_vpc.examplevpc.main_route_table_id
-  destination_cidr_block = "0.0.0.0/0"
-  gateway_id             = aws_internet_gateway.internet.id
+resource "aws_route" "existing" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
 }
 
-resource "aws_route" "igw-ipv6" {
-  route_table_id              = aws_vpc.examplevpc.main_route_table_id
-  destination_ipv6_cidr_block = "::/0"
-  gateway_id                  = aws_internet_gateway.internet.id
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.1.0/24"
+  gateway_id             = aws_internet_gateway.test.id
+
+  warn_on_overlapping_routes = true
+
+  depends_on = [aws_route.existing]
+}
+`, rName)
 }
 
-resource "aws_subnet" "router-network" {
-  cidr_block                      = "10.100.1.0/24"
-  vpc_id                          = aws_vpc.examplevpc.id
-  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 1)
-  assign_ipv6_address_on_creation = true
-  map_public_ip_on_launch         = true
-  availability_zone               = data.aws_availability_zones.available.names[0]
+func testAccAWSRouteConfigCreateBeforeDestroy(rName, destinationCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "tf-acc-route-ipv6-network-interface-router"
+    Name = %[1]q
   }
 }
 
-resource "aws_subnet" "client-network" {
-  cidr_block                      = "10.100.10.0/24"
-  vpc_id                          = aws_vpc.examplevpc.id
-  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 2)
-  assign_ipv6_address_on_creation = true
-  map_public_ip_on_launch         = false
-  availability_zone               = data.aws_availability_zones.available.names[0]
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "tf-acc-route-ipv6-network-interface-client"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "client-routes" {
-  vpc_id = aws_vpc.examplevpc.id
-}
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_route_table_association" "client-routes" {
-  route_table_id = aws_route_table.client-routes.id
-  subnet_id      = aws_subnet.client-network.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_instance" "test-router" {
-  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  subnet_id     = aws_subnet.router-network.id
-}
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = %[2]q
+  gateway_id             = aws_internet_gateway.test.id
 
-resource "aws_network_interface" "router-internal" {
-  subnet_id         = aws_subnet.client-network.id
-  source_dest_check = false
+  lifecycle {
+    create_before_destroy = true
+  }
 }
-
-resource "aws_network_interface_attachment" "router-internal" {
-  device_index         = 1
-  instance_id          = aws_instance.test-router.id
-  network_interface_id = aws_network_interface.router-internal.id
+`, rName, destinationCidr)
 }
 
-resource "aws_route" "internal-default-route" {
-  route_table_id         = aws_route_table.client-routes.id
-  destination_cidr_block = "0.0.0.0/0"
-  network_interface_id   = aws_network_interface.router-internal.id
-}
+func testAccAWSRouteConfigUpdateTargetType(rName, target string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
-resource "aws_route" "internal-default-route-ipv6" {
-  route_table_id              = aws_route_table.client-routes.id
-  destination_ipv6_cidr_block = "::/0"
-  network_interface_id        = aws_network_interface.router-internal.id
-}
-`)
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccAWSRouteConfigIpv6Instance() string {
-	return composeConfig(
-		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.router-network.availability_zone", "t2.small", "t3.small"),
-		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
-resource "aws_vpc" "examplevpc" {
-  cidr_block                       = "10.100.0.0/16"
-  enable_dns_hostnames             = true
-  assign_generated_ipv6_cidr_block = true
+resource "aws_vpc" "peer" {
+  cidr_block = "10.2.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-instance"
+    Name = %[1]q
   }
 }
 
-data "aws_availability_zones" "available" {
-  state = "available"
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
 
-  filter {
-    name   = "opt-in-status"
-    values = ["opt-in-not-required"]
+  tags = {
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "internet" {
-  vpc_id = aws_vpc.examplevpc.id
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-instance"
+    Name = %[1]q
   }
 }
 
-resource "aws_route" "igw" {
-  route_table_id         = aws_vpc.examplevpc.main_route_table_id
-  destination_cidr_block = "0.0.0.0/0"
-  gateway_id             = aws_internet_gateway.internet.id
-}
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_route" "igw-ipv6" {
-  route_table_id              = aws_vpc.examplevpc.main_route_table_id
-  destination_ipv6_cidr_block = "::/0"
-  gateway_id                  = aws_internet_gateway.internet.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_subnet" "router-network" {
-  cidr_block                      = "10.100.1.0/24"
-  vpc_id                          = aws_vpc.examplevpc.id
-  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 1)
-  assign_ipv6_address_on_creation = true
-  map_public_ip_on_launch         = true
-  availability_zone               = data.aws_availability_zones.available.names[0]
+resource "aws_eip" "test" {
+  vpc = true
 
   tags = {
-    Name = "tf-acc-route-ipv6-instance-router"
+    Name = %[1]q
   }
 }
 
-resource "aws_subnet" "client-network" {
-  cidr_block                      = "10.100.10.0/24"
-  vpc_id                          = aws_vpc.examplevpc.id
-  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 2)
-  assign_ipv6_address_on_creation = true
-  map_public_ip_on_launch         = false
-  availability_zone               = data.aws_availability_zones.available.names[0]
+resource "aws_nat_gateway" "test" {
+  allocation_id = aws_eip.test.id
+  subnet_id     = aws_subnet.test.id
 
   tags = {
-    Name = "tf-acc-route-ipv6-instance-client"
+    Name = %[1]q
   }
-}
 
-resource "aws_route_table" "client-routes" {
-  vpc_id = aws_vpc.examplevpc.id
+  depends_on = [aws_internet_gateway.test]
 }
 
-resource "aws_route_table_association" "client-routes" {
-  route_table_id = aws_route_table.client-routes.id
-  subnet_id      = aws_subnet.client-network.id
-}
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
 
-resource "aws_instance" "test-router" {
-  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  subnet_id     = aws_subnet.router-network.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "internal-default-route" {
-  route_table_id         = aws_route_table.client-routes.id
-  destination_cidr_block = "0.0.0.0/0"
-  instance_id            = aws_instance.test-router.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "internal-default-route-ipv6" {
-  route_table_id              = aws_route_table.client-routes.id
-  destination_ipv6_cidr_block = "::/0"
-  instance_id                 = aws_instance.test-router.id
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  %[2]s
 }
-`)
+`, rName, target))
 }
 
-func testAccAWSRouteConfigIpv6InstanceExpanded() string {
+func testAccAWSRouteConfigUpdateTargetTypeIpv6(rName, target string) string {
 	return composeConfig(
-		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.router-network.availability_zone", "t2.small", "t3.small"),
-		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
-resource "aws_vpc" "examplevpc" {
-  cidr_block                       = "10.100.0.0/16"
-  enable_dns_hostnames             = true
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
   assign_generated_ipv6_cidr_block = true
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-instance"
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+  ipv6_cidr_block   = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
   }
 }
 
-data "aws_availability_zones" "available" {
-  state = "available"
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
-  filter {
-    name   = "opt-in-status"
-    values = ["opt-in-not-required"]
+  tags = {
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "internet" {
-  vpc_id = aws_vpc.examplevpc.id
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-instance"
+    Name = %[1]q
   }
 }
 
-resource "aws_route" "igw" {
-  route_table_id         = aws_vpc.examplevpc.main_route_table_id
-  destination_cidr_block = "0.0.0.0/0"
-  gateway_id             = aws_internet_gateway.internet.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "igw-ipv6" {
-  route_table_id              = aws_vpc.examplevpc.main_route_table_id
-  destination_ipv6_cidr_block = "::0/0"
-  gateway_id                  = aws_internet_gateway.internet.id
+resource "aws_route" "test" {
+  route_table_id              = aws_route_table.test.id
+  destination_ipv6_cidr_block = "::/0"
+  %[2]s
+}
+`, rName, target))
 }
 
-resource "aws_subnet" "router-network" {
-  cidr_block                      = "10.100.1.0/24"
-  vpc_id                          = aws_vpc.examplevpc.id
-  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 1)
-  assign_ipv6_address_on_creation = true
-  map_public_ip_on_launch         = true
-  availability_zone               = data.aws_availability_zones.available.names[0]
+func testAccAWSRouteConfigGatewayID(rName, targetResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "tf-acc-route-ipv6-instance-router"
+    Name = %[1]q
   }
 }
 
-resource "aws_subnet" "client-network" {
-  cidr_block                      = "10.100.10.0/24"
-  vpc_id                          = aws_vpc.examplevpc.id
-  ipv6_cidr_block                 = cidrsubnet(aws_vpc.examplevpc.ipv6_cidr_block, 8, 2)
-  assign_ipv6_address_on_creation = true
-  map_public_ip_on_launch         = false
-  availability_zone               = data.aws_availability_zones.available.names[0]
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "tf-acc-route-ipv6-instance-client"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "client-routes" {
-  vpc_id = aws_vpc.examplevpc.id
-}
+resource "aws_vpn_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_route_table_association" "client-routes" {
-  route_table_id = aws_route_table.client-routes.id
-  subnet_id      = aws_subnet.client-network.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_instance" "test-router" {
-  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  subnet_id     = aws_subnet.router-network.id
-}
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_route" "internal-default-route" {
-  route_table_id         = aws_route_table.client-routes.id
-  destination_cidr_block = "0.0.0.0/0"
-  instance_id            = aws_instance.test-router.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "internal-default-route-ipv6" {
-  route_table_id              = aws_route_table.client-routes.id
-  destination_ipv6_cidr_block = "::0/0"
-  instance_id                 = aws_instance.test-router.id
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = %[2]s.id
 }
-`)
+`, rName, targetResourceName)
 }
 
-func testAccAWSRouteConfigIpv6PeeringConnection() string {
-	return `
-resource "aws_vpc" "foo" {
-  cidr_block                       = "10.0.0.0/16"
+func testAccAWSRouteConfigEgressOnlyGatewayID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
   assign_generated_ipv6_cidr_block = true
 
   tags = {
-    Name = "terraform-testacc-route-ipv6-peering-connection"
+    Name = %[1]q
   }
 }
 
-resource "aws_vpc" "bar" {
-  cidr_block                       = "10.1.0.0/16"
-  assign_generated_ipv6_cidr_block = true
-}
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_vpc_peering_connection" "foo" {
-  vpc_id      = aws_vpc.foo.id
-  peer_vpc_id = aws_vpc.bar.id
-  auto_accept = true
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route_table" "peering" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "pc" {
-  route_table_id              = aws_route_table.peering.id
-  destination_ipv6_cidr_block = aws_vpc.bar.ipv6_cidr_block
-  vpc_peering_connection_id   = aws_vpc_peering_connection.foo.id
+resource "aws_route" "test" {
+  route_table_id              = aws_route_table.test.id
+  destination_ipv6_cidr_block = "::/0"
+  egress_only_gateway_id      = aws_egress_only_internet_gateway.test.id
 }
-`
+`, rName)
 }
 
-func testAccAWSRouteConfigIpv6() string {
-	return `
-resource "aws_vpc" "foo" {
-  cidr_block                       = "10.1.0.0/16"
-  assign_generated_ipv6_cidr_block = true
+func testAccAWSRouteConfigNatGatewayID(rName, targetResourceName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-ipv6"
+    Name = %[1]q
   }
 }
 
-resource "aws_egress_only_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route_table" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "bar" {
-  route_table_id              = aws_route_table.foo.id
-  destination_ipv6_cidr_block = "::/0"
-  egress_only_gateway_id      = aws_egress_only_internet_gateway.foo.id
+resource "aws_eip" "test1" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
 }
-`
+
+resource "aws_eip" "test2" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccAWSRouteConfigIpv6Expanded() string {
-	return `
-resource "aws_vpc" "foo" {
-  cidr_block                       = "10.1.0.0/16"
-  assign_generated_ipv6_cidr_block = true
+resource "aws_nat_gateway" "test1" {
+  allocation_id = aws_eip.test1.id
+  subnet_id     = aws_subnet.test.id
 
   tags = {
-    Name = "terraform-testacc-route-ipv6"
+    Name = %[1]q
   }
+
+  depends_on = [aws_internet_gateway.test]
 }
 
-resource "aws_egress_only_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_nat_gateway" "test2" {
+  allocation_id = aws_eip.test2.id
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_internet_gateway.test]
 }
 
-resource "aws_route_table" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_route" "bar" {
-  route_table_id              = aws_route_table.foo.id
-  destination_ipv6_cidr_block = "::0/0"
-  egress_only_gateway_id      = aws_egress_only_internet_gateway.foo.id
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  nat_gateway_id         = %[2]s.id
 }
-`
+`, rName, targetResourceName))
 }
 
-func testAccAWSRouteBasicConfigChangeCidr() string {
-	return `
-resource "aws_vpc" "foo" {
+func testAccAWSRouteConfigInstanceID(rName, targetResourceName string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableAZsNoOptInConfig(),
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
   cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-change-cidr"
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
 
   tags = {
-    Name = "terraform-testacc-route-change-cidr"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "foo" {
-  vpc_id = aws_vpc.foo.id
-}
+resource "aws_instance" "test1" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
 
-resource "aws_route" "bar" {
-  route_table_id         = aws_route_table.foo.id
-  destination_cidr_block = "10.2.0.0/16"
-  gateway_id             = aws_internet_gateway.foo.id
+  tags = {
+    Name = %[1]q
+  }
 }
-`
+
+resource "aws_instance" "test2" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccAWSRouteNoopChange() string {
-	return composeConfig(
-		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "t2.nano", "t3.nano"),
-		testAccLatestAmazonLinuxHvmEbsAmiConfig(), `
-data "aws_availability_zones" "available" {
-  state = "available"
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
-  filter {
-    name   = "opt-in-status"
-    values = ["opt-in-not-required"]
+  tags = {
+    Name = %[1]q
   }
 }
 
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  instance_id            = %[2]s.id
+}
+`, rName, targetResourceName))
+}
+
+func testAccAWSRouteConfigNetworkInterfaceID(rName, targetResourceName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
 resource "aws_vpc" "test" {
-  cidr_block = "10.10.0.0/16"
+  cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-noop-change"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "test" {
-  vpc_id = aws_vpc.test.id
-}
-
 resource "aws_subnet" "test" {
   availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
   vpc_id            = aws_vpc.test.id
-  cidr_block        = "10.10.10.0/24"
 
   tags = {
-    Name = "tf-acc-route-noop-change"
+    Name = %[1]q
   }
 }
 
-resource "aws_route" "test" {
-  route_table_id         = aws_route_table.test.id
-  destination_cidr_block = "0.0.0.0/0"
-  instance_id            = aws_instance.nat.id
-}
+resource "aws_network_interface" "test1" {
+  subnet_id = aws_subnet.test.id
 
-resource "aws_instance" "nat" {
-  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  subnet_id     = aws_subnet.test.id
-}
-`)
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccAWSRouteWithVPCEndpoint() string {
-	return `
-data "aws_region" "current" {}
-
-resource "aws_vpc" "foo" {
-  cidr_block = "10.1.0.0/16"
+resource "aws_network_interface" "test2" {
+  subnet_id = aws_subnet.test.id
 
   tags = {
-    Name = "terraform-testacc-route-with-vpc-endpoint"
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "terraform-testacc-route-with-vpc-endpoint"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "foo" {
-  vpc_id = aws_vpc.foo.id
-}
-
-resource "aws_route" "bar" {
-  route_table_id         = aws_route_table.foo.id
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
   destination_cidr_block = "10.3.0.0/16"
-  gateway_id             = aws_internet_gateway.foo.id
-
-  # Forcing endpoint to create before route - without this the crash is a race.
-  depends_on = [aws_vpc_endpoint.baz]
-}
-
-resource "aws_vpc_endpoint" "baz" {
-  vpc_id          = aws_vpc.foo.id
-  service_name    = "com.amazonaws.${data.aws_region.current.name}.s3"
-  route_table_ids = [aws_route_table.foo.id]
+  network_interface_id   = %[2]s.id
 }
-`
+`, rName, targetResourceName))
 }
 
-func testAccAWSRouteNewRouteTable() string {
-	return `
-resource "aws_vpc" "foo" {
+func testAccAWSRouteConfigVpcPeeringConnectionID(rName, targetResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
   cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-basic"
+    Name = %[1]q
   }
 }
 
-resource "aws_vpc" "bar" {
+resource "aws_vpc" "peer1" {
   cidr_block = "10.2.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-new-route-table"
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_vpc" "peer2" {
+  cidr_block = "10.3.0.0/16"
 
   tags = {
-    Name = "terraform-testacc-route-basic"
+    Name = %[1]q
   }
 }
 
-resource "aws_internet_gateway" "bar" {
-  vpc_id = aws_vpc.bar.id
+resource "aws_vpc_peering_connection" "test1" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer1.id
+  auto_accept = true
 
   tags = {
-    Name = "terraform-testacc-route-new-route-table"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "foo" {
-  vpc_id = aws_vpc.foo.id
+resource "aws_vpc_peering_connection" "test2" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer2.id
+  auto_accept = true
 
   tags = {
-    Name = "terraform-testacc-route-basic"
+    Name = %[1]q
   }
 }
 
-resource "aws_route_table" "bar" {
-  vpc_id = aws_vpc.bar.id
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "terraform-testacc-route-new-route-table"
+    Name = %[1]q
   }
 }
 
-resource "aws_route" "bar" {
-  route_table_id         = aws_route_table.bar.id
-  destination_cidr_block = "10.4.0.0/16"
-  gateway_id             = aws_internet_gateway.bar.id
+resource "aws_route" "test" {
+  route_table_id            = aws_route_table.test.id
+  destination_cidr_block    = "10.4.0.0/16"
+  vpc_peering_connection_id = %[2]s.id
 }
-`
+`, rName, targetResourceName)
 }
 
-func testAccAWSRouteConfigTransitGatewayIDDestinatationCidrBlock() string {
-	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(),
-		`
-# IncorrectState: Transit Gateway is not available in availability zone usw2-az4	
-
+func testAccAWSRouteConfigAdoptExistingBase(rName string) string {
+	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
-  cidr_block = "10.0.0.0/16"
+  cidr_block = "10.1.0.0/16"
 
   tags = {
-    Name = "tf-acc-test-ec2-route-transit-gateway-id"
+    Name = %[1]q
   }
 }
 
-resource "aws_subnet" "test" {
-  availability_zone = data.aws_availability_zones.available.names[0]
-  cidr_block        = "10.0.0.0/24"
-  vpc_id            = aws_vpc.test.id
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
   tags = {
-    Name = "tf-acc-test-ec2-route-transit-gateway-id"
+    Name = %[1]q
   }
 }
 
-resource "aws_ec2_transit_gateway" "test" {}
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
-  subnet_ids         = [aws_subnet.test.id]
-  transit_gateway_id = aws_ec2_transit_gateway.test.id
-  vpc_id             = aws_vpc.test.id
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
 }
 
+func testAccAWSRouteConfigAdoptExisting(rName string, adoptExisting bool) string {
+	return composeConfig(
+		testAccAWSRouteConfigAdoptExistingBase(rName),
+		fmt.Sprintf(`
 resource "aws_route" "test" {
-  destination_cidr_block = "0.0.0.0/0"
-  route_table_id         = aws_vpc.test.default_route_table_id
-  transit_gateway_id     = aws_ec2_transit_gateway_vpc_attachment.test.transit_gateway_id
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
+  adopt_existing         = %[1]t
 }
-`)
+`, adoptExisting))
 }
 
-func testAccAWSRouteConfigConditionalIpv4Ipv6(rName string, ipv6Route bool) string {
+func testAccAWSRouteConfigDescription(rName, destinationCidr, description string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
-  cidr_block                       = "10.1.0.0/16"
-  assign_generated_ipv6_cidr_block = true
-
-  tags = {
-    Name = %[1]q
-  }
-}
-
-resource "aws_egress_only_internet_gateway" "test" {
-  vpc_id = aws_vpc.test.id
+  cidr_block = "10.1.0.0/16"
 
   tags = {
     Name = %[1]q
@@ -1310,110 +3834,164 @@ resource "aws_route_table" "test" {
   }
 }
 
-locals {
-  ipv6             = %[2]t
-  destination      = "0.0.0.0/0"
-  destination_ipv6 = "::/0"
-}
-
 resource "aws_route" "test" {
-  route_table_id = aws_route_table.test.id
-  gateway_id     = aws_internet_gateway.test.id
-
-  destination_cidr_block      = local.ipv6 ? "" : local.destination
-  destination_ipv6_cidr_block = local.ipv6 ? local.destination_ipv6 : ""
-}
-`, rName, ipv6Route)
-}
-
-func testAccAWSRouteResourceConfigLocalGatewayID() string {
-	return `
-data "aws_ec2_local_gateways" "all" {}
-data "aws_ec2_local_gateway" "first" {
-  id = tolist(data.aws_ec2_local_gateways.all.ids)[0]
-}
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = %[2]q
+  gateway_id             = aws_internet_gateway.test.id
+  description            = %[3]q
+
+  allow_destination_change_in_place = true
+}
+
+data "aws_route_table" "test" {
+  route_table_id = aws_route.test.route_table_id
+}
+`, rName, destinationCidr, description)
+}
+
+const testEc2DescribeRouteTablesResponsePage1 = `<DescribeRouteTablesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <routeTableSet>
+    <item>
+      <routeTableId>rtb-12345678</routeTableId>
+      <vpcId>vpc-12345678</vpcId>
+      <routeSet>
+        <item>
+          <destinationCidrBlock>10.1.0.0/16</destinationCidrBlock>
+          <gatewayId>local</gatewayId>
+          <state>active</state>
+          <origin>CreateRouteTable</origin>
+        </item>
+      </routeSet>
+    </item>
+  </routeTableSet>
+  <nextToken>page2</nextToken>
+</DescribeRouteTablesResponse>`
+
+const testEc2DescribeRouteTablesResponsePage2 = `<DescribeRouteTablesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <routeTableSet>
+    <item>
+      <routeTableId>rtb-12345678</routeTableId>
+      <vpcId>vpc-12345678</vpcId>
+      <routeSet>
+        <item>
+          <destinationCidrBlock>10.3.0.0/16</destinationCidrBlock>
+          <gatewayId>igw-12345678</gatewayId>
+          <state>active</state>
+          <origin>CreateRoute</origin>
+        </item>
+        <item>
+          <destinationIpv6CidrBlock>::/0</destinationIpv6CidrBlock>
+          <gatewayId>igw-12345678</gatewayId>
+          <state>active</state>
+          <origin>CreateRoute</origin>
+        </item>
+        <item>
+          <destinationPrefixListId>pl-12345678</destinationPrefixListId>
+          <gatewayId>vpce-12345678</gatewayId>
+          <state>active</state>
+          <origin>CreateRoute</origin>
+        </item>
+      </routeSet>
+    </item>
+  </routeTableSet>
+</DescribeRouteTablesResponse>`
+
+const testEc2DescribeRouteTablesResponseNotFound = `<Response>
+  <Errors>
+    <Error>
+      <Code>InvalidRouteTableID.NotFound</Code>
+      <Message>The routeTable ID 'rtb-87654321' does not exist</Message>
+    </Error>
+  </Errors>
+  <RequestID>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestID>
+</Response>`
+
+func testAccAWSRouteConfigTagsBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
-data "aws_ec2_local_gateway_route_tables" "all" {}
-data "aws_ec2_local_gateway_route_table" "first" {
-  local_gateway_route_table_id = tolist(data.aws_ec2_local_gateway_route_tables.all.ids)[0]
+  tags = {
+    Name = %[1]q
+  }
 }
 
-resource "aws_vpc" "test" {
-  cidr_block = "10.0.0.0/16"
-}
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
 
-resource "aws_ec2_local_gateway_route_table_vpc_association" "example" {
-  local_gateway_route_table_id = data.aws_ec2_local_gateway_route_table.first.id
-  vpc_id                       = aws_vpc.test.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
 resource "aws_route_table" "test" {
-  vpc_id     = aws_vpc.test.id
-  depends_on = [aws_ec2_local_gateway_route_table_vpc_association.example]
-}
+  vpc_id = aws_vpc.test.id
 
-resource "aws_route" "test" {
-  route_table_id         = aws_route_table.test.id
-  destination_cidr_block = "172.16.1.0/24"
-  local_gateway_id       = data.aws_ec2_local_gateway.first.id
+  tags = {
+    Name = %[1]q
+  }
 }
-`
+`, rName)
 }
 
-func testAccAWSRouteResourceConfigVpcEndpointId(rName string) string {
+func testAccAWSRouteConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return composeConfig(
-		testAccAvailableAZsNoOptInConfig(),
+		testAccAWSRouteConfigTagsBase(rName),
 		fmt.Sprintf(`
-data "aws_caller_identity" "current" {}
-
-resource "aws_vpc" "test" {
-  cidr_block = "10.10.10.0/25"
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
 
   tags = {
-    Name = "tf-acc-test-load-balancer"
+    %[1]q = %[2]q
   }
 }
+`, tagKey1, tagValue1))
+}
 
-resource "aws_subnet" "test" {
-  availability_zone = data.aws_availability_zones.available.names[0]
-  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 2, 0)
-  vpc_id            = aws_vpc.test.id
+func testAccAWSRouteConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSRouteConfigTagsBase(rName),
+		fmt.Sprintf(`
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
 
   tags = {
-    Name = "tf-acc-test-load-balancer"
+    %[1]q = %[2]q
+    %[3]q = %[4]q
   }
 }
-
-resource "aws_lb" "test" {
-  load_balancer_type = "gateway"
-  name               = %[1]q
-
-  subnet_mapping {
-    subnet_id = aws_subnet.test.id
-  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
-resource "aws_vpc_endpoint_service" "test" {
-  acceptance_required        = false
-  allowed_principals         = [data.aws_caller_identity.current.arn]
-  gateway_load_balancer_arns = [aws_lb.test.arn]
-}
+func testAccAWSRouteConfigInstanceIDNotFound(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
-resource "aws_vpc_endpoint" "test" {
-  service_name      = aws_vpc_endpoint_service.test.service_name
-  subnet_ids        = [aws_subnet.test.id]
-  vpc_endpoint_type = aws_vpc_endpoint_service.test.service_type
-  vpc_id            = aws_vpc.test.id
+  tags = {
+    Name = %[1]q
+  }
 }
 
 resource "aws_route_table" "test" {
   vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
 resource "aws_route" "test" {
   route_table_id         = aws_route_table.test.id
-  destination_cidr_block = "172.16.1.0/24"
-  vpc_endpoint_id        = aws_vpc_endpoint.test.id
+  destination_cidr_block  = "10.3.0.0/16"
+  instance_id             = "i-0123456789abcdef0"
+  validate_instance_state = true
 }
-`, rName))
+`, rName)
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 )
 
@@ -26,7 +28,7 @@ func resourceAwsSubnet() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		SchemaVersion: 1,
@@ -472,12 +474,43 @@ func resourceAwsSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if _, err := wait.WaitForState(); err != nil {
+		// Name the network interfaces, often managed by other services, that still hold the subnet.
+		if isResourceTimeoutError(err) {
+			if networkInterfaces := subnetNetworkInterfacesDescription(conn, d.Id()); networkInterfaces != "" {
+				return fmt.Errorf("error deleting subnet (%s): %w; network interfaces still in use: %s", d.Id(), err, networkInterfaces)
+			}
+		}
+
 		return fmt.Errorf("error deleting subnet (%s): %w", d.Id(), err)
 	}
 
 	return nil
 }
 
+// subnetNetworkInterfacesDescription returns a summary of the network interfaces in the specified subnet,
+// or an empty string if there are none or they can't be described.
+func subnetNetworkInterfacesDescription(conn *ec2.EC2, subnetID string) string {
+	networkInterfaces, err := finder.NetworkInterfacesBySubnetID(conn, subnetID)
+
+	if err != nil {
+		log.Printf("[WARN] Error describing network interfaces in subnet (%s): %s", subnetID, err)
+		return ""
+	}
+
+	var descriptions []string
+	for _, networkInterface := range networkInterfaces {
+		description := fmt.Sprintf("%s (description: %q, requester: %q", aws.StringValue(networkInterface.NetworkInterfaceId), aws.StringValue(networkInterface.Description), aws.StringValue(networkInterface.RequesterId))
+
+		if attachment := networkInterface.Attachment; attachment != nil {
+			description += fmt.Sprintf(", attachment owner: %q", aws.StringValue(attachment.InstanceOwnerId))
+		}
+
+		descriptions = append(descriptions, description+")")
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
 // SubnetStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch a Subnet.
 func SubnetStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
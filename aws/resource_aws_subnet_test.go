@@ -371,6 +371,7 @@ func TestAccAWSSubnet_CustomerOwnedIpv4Pool(t *testing.T) {
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSOutpostsOutposts(t) },
+		ErrorCheck:   testAccErrorCheckSkipSubnetOutposts(t),
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckSubnetDestroy,
 		Steps: []resource.TestStep{
@@ -396,6 +397,7 @@ func TestAccAWSSubnet_MapCustomerOwnedIpOnLaunch(t *testing.T) {
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSOutpostsOutposts(t) },
+		ErrorCheck:   testAccErrorCheckSkipSubnetOutposts(t),
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckSubnetDestroy,
 		Steps: []resource.TestStep{
@@ -411,6 +413,34 @@ func TestAccAWSSubnet_MapCustomerOwnedIpOnLaunch(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccSubnetConfigMapCustomerOwnedIpOnLaunch(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "map_customer_owned_ip_on_launch", "false"),
+				),
+			},
+			{
+				Config: testAccSubnetConfigMapCustomerOwnedIpOnLaunch(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "map_customer_owned_ip_on_launch", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSubnet_CustomerOwnedIpv4Pool_RequiresOutpost(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubnetConfigCustomerOwnedIpv4PoolWithoutOutpost(),
+				ExpectError: regexp.MustCompile(`all of .+ must be specified`),
+			},
 		},
 	})
 }
@@ -461,6 +491,7 @@ func TestAccAWSSubnet_outpost(t *testing.T) {
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t); testAccPreCheckAWSOutpostsOutposts(t) },
+		ErrorCheck:    testAccErrorCheckSkipSubnetOutposts(t),
 		IDRefreshName: resourceName,
 		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckSubnetDestroy,
@@ -481,6 +512,15 @@ func TestAccAWSSubnet_outpost(t *testing.T) {
 	})
 }
 
+// testAccErrorCheckSkipSubnetOutposts skips subnet tests when the account has no usable Outposts resources
+func testAccErrorCheckSkipSubnetOutposts(t *testing.T) resource.ErrorCheckFunc {
+	return testAccErrorCheckSkipMessagesContaining(t,
+		"no Outposts Outpost found matching criteria",
+		"no matching EC2 COIP Pools found",
+		"no matching COIP Pool found",
+	)
+}
+
 func testAccCheckAwsSubnetIpv6BeforeUpdate(subnet *ec2.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -856,6 +896,29 @@ resource "aws_subnet" "test" {
 `, mapCustomerOwnedIpOnLaunch)
 }
 
+func testAccSubnetConfigCustomerOwnedIpv4PoolWithoutOutpost() string {
+	return `
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-subnet-customer-owned-ipv4-pool"
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block                      = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+  customer_owned_ipv4_pool        = "ipv4pool-coip-12345678"
+  map_customer_owned_ip_on_launch = true
+  vpc_id                          = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-subnet-customer-owned-ipv4-pool"
+  }
+}
+`
+}
+
 func testAccSubnetConfigMapPublicIpOnLaunch(mapPublicIpOnLaunch bool) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -167,10 +167,14 @@ func resourceAwsVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(vpc.Ipv6CidrBlockAssociationSet) > 0 && vpc.Ipv6CidrBlockAssociationSet[0] != nil {
+		associationID := aws.StringValue(vpcResp.Vpc.Ipv6CidrBlockAssociationSet[0].AssociationId)
+
 		log.Printf("[DEBUG] Waiting for EC2 VPC (%s) IPv6 CIDR to become associated", d.Id())
-		if err := waitForEc2VpcIpv6CidrBlockAssociationCreate(conn, d.Id(), aws.StringValue(vpcResp.Vpc.Ipv6CidrBlockAssociationSet[0].AssociationId)); err != nil {
+		if err := waitForEc2VpcIpv6CidrBlockAssociationCreate(conn, d.Id(), associationID); err != nil {
 			return fmt.Errorf("error waiting for EC2 VPC (%s) IPv6 CIDR to become associated: %s", d.Id(), err)
 		}
+
+		d.Set("ipv6_association_id", associationID)
 	}
 
 	// You cannot modify the DNS resolution and DNS hostnames attributes in the same request. Use separate requests for each attribute.
@@ -273,17 +277,19 @@ func resourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("owner_id", vpc.OwnerId)
 
-	// Make sure those values are set, if an IPv6 block exists it'll be set in the loop
+	// Additional IPv6 CIDR blocks can be associated via aws_vpc_ipv6_cidr_block_association,
+	// so only report the association already tracked in state.
+	ipv6AssociationID := d.Get("ipv6_association_id").(string)
+
+	// Make sure those values are set, if an IPv6 block exists it'll be set below
 	d.Set("assign_generated_ipv6_cidr_block", false)
 	d.Set("ipv6_association_id", "")
 	d.Set("ipv6_cidr_block", "")
 
-	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
-		if aws.StringValue(a.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated { //we can only ever have 1 IPv6 block associated at once
-			d.Set("assign_generated_ipv6_cidr_block", true)
-			d.Set("ipv6_association_id", a.AssociationId)
-			d.Set("ipv6_cidr_block", a.Ipv6CidrBlock)
-		}
+	if a := vpcIpv6CidrBlockAssociationByID(vpc, ipv6AssociationID); a != nil {
+		d.Set("assign_generated_ipv6_cidr_block", true)
+		d.Set("ipv6_association_id", a.AssociationId)
+		d.Set("ipv6_cidr_block", a.Ipv6CidrBlock)
 	}
 
 	enableDnsHostnames, err := finder.VpcAttribute(conn, aws.StringValue(vpc.VpcId), ec2.VpcAttributeNameEnableDnsHostnames)
@@ -482,6 +488,8 @@ func resourceAwsVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 			if err := waitForEc2VpcIpv6CidrBlockAssociationCreate(conn, d.Id(), aws.StringValue(resp.Ipv6CidrBlockAssociation.AssociationId)); err != nil {
 				return fmt.Errorf("error waiting for EC2 VPC (%s) IPv6 CIDR to become associated: %s", d.Id(), err)
 			}
+
+			d.Set("ipv6_association_id", resp.Ipv6CidrBlockAssociation.AssociationId)
 		} else {
 			associationID := d.Get("ipv6_association_id").(string)
 			modifyOpts := &ec2.DisassociateVpcCidrBlockInput{
@@ -497,6 +505,8 @@ func resourceAwsVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 			if err := waitForEc2VpcIpv6CidrBlockAssociationDelete(conn, d.Id(), associationID); err != nil {
 				return fmt.Errorf("error waiting for EC2 VPC (%s) IPv6 CIDR to become disassociated: %s", d.Id(), err)
 			}
+
+			d.Set("ipv6_association_id", "")
 		}
 	}
 
@@ -509,7 +519,7 @@ func resourceAwsVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 			"[INFO] Modifying instance_tenancy vpc attribute for %s: %#v",
 			d.Id(), modifyOpts)
 		if _, err := conn.ModifyVpcTenancy(modifyOpts); err != nil {
-			return err
+			return fmt.Errorf("error updating EC2 VPC (%s) Instance Tenancy: %w", d.Id(), err)
 		}
 	}
 
@@ -606,6 +616,43 @@ func VPCStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	}
 }
 
+// vpcIpv6CidrBlockAssociationByID returns the associated IPv6 CIDR block with the specified association ID.
+// An empty association ID never matches.
+func vpcIpv6CidrBlockAssociationByID(vpc *ec2.Vpc, associationID string) *ec2.VpcIpv6CidrBlockAssociation {
+	if associationID == "" {
+		return nil
+	}
+
+	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
+		if a == nil || a.Ipv6CidrBlockState == nil || aws.StringValue(a.Ipv6CidrBlockState.State) != ec2.VpcCidrBlockStateCodeAssociated {
+			continue
+		}
+
+		if aws.StringValue(a.AssociationId) == associationID {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// vpcFirstAmazonProvidedIpv6CidrBlockAssociation returns the first associated Amazon-provided IPv6 CIDR block of the VPC.
+func vpcFirstAmazonProvidedIpv6CidrBlockAssociation(vpc *ec2.Vpc) *ec2.VpcIpv6CidrBlockAssociation {
+	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
+		if a == nil || a.Ipv6CidrBlockState == nil || aws.StringValue(a.Ipv6CidrBlockState.State) != ec2.VpcCidrBlockStateCodeAssociated {
+			continue
+		}
+
+		if v := aws.StringValue(a.Ipv6Pool); v != "" && v != "Amazon" {
+			continue
+		}
+
+		return a
+	}
+
+	return nil
+}
+
 func Ipv6CidrStateRefreshFunc(conn *ec2.EC2, id string, associationId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		describeVpcOpts := &ec2.DescribeVpcsInput{
@@ -744,6 +791,21 @@ func resourceAwsVpcSetMainRouteTable(conn *ec2.EC2, vpcid string) (string, error
 func resourceAwsVpcInstanceImport(
 	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("assign_generated_ipv6_cidr_block", false)
+
+	vpc, err := vpcDescribe(meta.(*AWSClient).ec2conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading EC2 VPC (%s): %w", d.Id(), err)
+	}
+
+	// There is no record of which IPv6 CIDR block the VPC was created with,
+	// so adopt its first Amazon-provided block.
+	if vpc != nil {
+		if a := vpcFirstAmazonProvidedIpv6CidrBlockAssociation(vpc); a != nil {
+			d.Set("ipv6_association_id", a.AssociationId)
+		}
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1,9 +1,11 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 const (
@@ -120,6 +123,34 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"subnet_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"subnet_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -142,6 +173,8 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceAwsVpcEndpointCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(Ec2VpcEndpointCreationTimeout),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -288,6 +321,14 @@ func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error setting subnet_ids: %s", err)
 	}
+	subnetConfigurations, err := readVpcEndpointSubnetConfigurations(conn, vpce.NetworkInterfaceIds)
+	if err != nil {
+		return fmt.Errorf("error reading VPC Endpoint (%s) network interfaces: %s", d.Id(), err)
+	}
+	err = d.Set("subnet_configuration", subnetConfigurations)
+	if err != nil {
+		return fmt.Errorf("error setting subnet_configuration: %s", err)
+	}
 	// VPC endpoints don't have types in GovCloud, so set type to default if empty
 	if vpceType := aws.StringValue(vpce.VpcEndpointType); vpceType == "" {
 		d.Set("vpc_endpoint_type", ec2.VpcEndpointTypeGateway)
@@ -497,6 +538,30 @@ func vpcEndpointWaitUntilDeleted(conn *ec2.EC2, vpceID string, timeout time.Dura
 	return err
 }
 
+// resourceAwsVpcEndpointCustomizeDiff validates the arguments of a Gateway Load Balancer endpoint,
+// which has a single network interface and supports neither security groups nor private DNS.
+func resourceAwsVpcEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("vpc_endpoint_type").(string) != ec2.VpcEndpointTypeGatewayLoadBalancer {
+		return nil
+	}
+
+	if diff.NewValueKnown("subnet_ids") {
+		if n := diff.Get("subnet_ids").(*schema.Set).Len(); n != 1 {
+			return fmt.Errorf("a %s VPC Endpoint must have exactly one subnet, got %d", ec2.VpcEndpointTypeGatewayLoadBalancer, n)
+		}
+	}
+
+	if diff.NewValueKnown("security_group_ids") && diff.Get("security_group_ids").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("security_group_ids cannot be specified for a %s VPC Endpoint", ec2.VpcEndpointTypeGatewayLoadBalancer)
+	}
+
+	if diff.Get("private_dns_enabled").(bool) {
+		return fmt.Errorf("private_dns_enabled cannot be enabled for a %s VPC Endpoint", ec2.VpcEndpointTypeGatewayLoadBalancer)
+	}
+
+	return nil
+}
+
 func setVpcEndpointCreateList(d *schema.ResourceData, key string, c *[]*string) {
 	if v, ok := d.GetOk(key); ok {
 		list := v.(*schema.Set)
@@ -537,6 +602,46 @@ func flattenVpcEndpointDnsEntries(dnsEntries []*ec2.DnsEntry) []interface{} {
 	return vDnsEntries
 }
 
+// readVpcEndpointSubnetConfigurations returns the subnet, availability zone and IP addresses
+// of each of the specified network interfaces of an interface VPC endpoint, ordered by subnet.
+func readVpcEndpointSubnetConfigurations(conn *ec2.EC2, networkInterfaceIds []*string) ([]interface{}, error) {
+	if len(networkInterfaceIds) == 0 {
+		return []interface{}{}, nil
+	}
+
+	networkInterfaces, err := finder.NetworkInterfacesByIDs(conn, networkInterfaceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenVpcEndpointSubnetConfigurations(networkInterfaces), nil
+}
+
+func flattenVpcEndpointSubnetConfigurations(networkInterfaces []*ec2.NetworkInterface) []interface{} {
+	sort.Slice(networkInterfaces, func(i, j int) bool {
+		return aws.StringValue(networkInterfaces[i].SubnetId) < aws.StringValue(networkInterfaces[j].SubnetId)
+	})
+
+	vSubnetConfigurations := []interface{}{}
+
+	for _, networkInterface := range networkInterfaces {
+		ipv6 := ""
+		if len(networkInterface.Ipv6Addresses) > 0 {
+			ipv6 = aws.StringValue(networkInterface.Ipv6Addresses[0].Ipv6Address)
+		}
+
+		vSubnetConfigurations = append(vSubnetConfigurations, map[string]interface{}{
+			"availability_zone":    aws.StringValue(networkInterface.AvailabilityZone),
+			"ipv4":                 aws.StringValue(networkInterface.PrivateIpAddress),
+			"ipv6":                 ipv6,
+			"network_interface_id": aws.StringValue(networkInterface.NetworkInterfaceId),
+			"subnet_id":            aws.StringValue(networkInterface.SubnetId),
+		})
+	}
+
+	return vSubnetConfigurations
+}
+
 func flattenVpcEndpointSecurityGroupIds(groups []*ec2.SecurityGroupIdentifier) *schema.Set {
 	vSecurityGroupIds := []interface{}{}
 
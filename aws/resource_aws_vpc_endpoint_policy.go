@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+)
+
+func resourceAwsVpcEndpointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointPolicyPut,
+		Read:   resourceAwsVpcEndpointPolicyRead,
+		Update: resourceAwsVpcEndpointPolicyPut,
+		Delete: resourceAwsVpcEndpointPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsVpcEndpointPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("vpc_endpoint_id").(string)
+	req := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(endpointID),
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy"))
+	if err != nil {
+		return fmt.Errorf("policy contains an invalid JSON: %s", err)
+	}
+
+	if policy == "" {
+		req.ResetPolicy = aws.Bool(true)
+	} else {
+		req.PolicyDocument = aws.String(policy)
+	}
+
+	log.Printf("[DEBUG] Updating VPC Endpoint Policy: %#v", req)
+	if _, err := conn.ModifyVpcEndpoint(req); err != nil {
+		return fmt.Errorf("error updating VPC Endpoint (%s) policy: %w", endpointID, err)
+	}
+
+	d.SetId(endpointID)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := vpcEndpointWaitUntilAvailable(conn, endpointID, timeout); err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint (%s) to become available: %w", endpointID, err)
+	}
+
+	return resourceAwsVpcEndpointPolicyRead(d, meta)
+}
+
+func resourceAwsVpcEndpointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpceRaw, state, err := vpcEndpointStateRefresh(conn, d.Id())()
+	if err != nil && state != "failed" {
+		return fmt.Errorf("error reading VPC Endpoint (%s): %w", d.Id(), err)
+	}
+
+	switch state {
+	case "deleted", "deleting", "failed", "expired", "rejected":
+		log.Printf("[WARN] VPC Endpoint (%s) in state (%s), removing VPC Endpoint Policy from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	vpce := vpceRaw.(*ec2.VpcEndpoint)
+
+	d.Set("vpc_endpoint_id", d.Id())
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(vpce.PolicyDocument))
+	if err != nil {
+		return fmt.Errorf("policy contains an invalid JSON: %s", err)
+	}
+
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsVpcEndpointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Destroying the resource restores the default full access policy.
+	req := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+		ResetPolicy:   aws.Bool(true),
+	}
+
+	log.Printf("[DEBUG] Resetting VPC Endpoint Policy: %#v", req)
+	_, err := conn.ModifyVpcEndpoint(req)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcEndpointIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting VPC Endpoint (%s) policy: %w", d.Id(), err)
+	}
+
+	if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint (%s) to become available: %w", d.Id(), err)
+	}
+
+	return nil
+}
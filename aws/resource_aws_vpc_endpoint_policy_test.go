@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSVpcEndpointPolicy_basic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint_policy.test"
+	endpointResourceName := "aws_vpc_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, "ReadOnly", "dynamodb:DescribeTable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(endpointResourceName, &endpoint),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_id", endpointResourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`dynamodb:DescribeTable`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, "ReadOnly", "dynamodb:ListTables"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(endpointResourceName, &endpoint),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`dynamodb:ListTables`)),
+				),
+			},
+			{
+				// Destroying the policy restores the default full access policy.
+				Config: testAccVpcEndpointPolicyConfigBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(endpointResourceName, &endpoint),
+					resource.TestMatchResourceAttr(endpointResourceName, "policy", regexp.MustCompile(`"Action":"\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpointPolicy_disappears_VpcEndpoint(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	endpointResourceName := "aws_vpc_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, "ReadOnly", "dynamodb:DescribeTable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(endpointResourceName, &endpoint),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcEndpoint(), endpointResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVpcEndpointPolicyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_vpc_endpoint_service" "test" {
+  service = "dynamodb"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  service_name = data.aws_vpc_endpoint_service.test.service_name
+  vpc_id       = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVpcEndpointPolicyConfig(rName, sid, action string) string {
+	return composeConfig(
+		testAccVpcEndpointPolicyConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_vpc_endpoint_policy" "test" {
+  vpc_endpoint_id = aws_vpc_endpoint.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = %[1]q
+      Principal = "*"
+      Action    = [%[2]q]
+      Effect    = "Allow"
+      Resource  = "*"
+    }]
+  })
+}
+`, sid, action))
+}
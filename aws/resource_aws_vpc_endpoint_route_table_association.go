@@ -77,14 +77,7 @@ func resourceAwsVpcEndpointRouteTableAssociationRead(d *schema.ResourceData, met
 		return err
 	}
 
-	found := false
-	for _, id := range vpce.RouteTableIds {
-		if aws.StringValue(id) == rtId {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if !vpcEndpointHasId(vpce.RouteTableIds, rtId) {
 		log.Printf("[WARN] VPC Endpoint/Route Table association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -134,6 +127,15 @@ func resourceAwsVpcEndpointRouteTableAssociationImport(d *schema.ResourceData, m
 	rtId := parts[1]
 	log.Printf("[DEBUG] Importing VPC Endpoint (%s) Route Table (%s) association", vpceId, rtId)
 
+	vpce, err := findResourceVpcEndpoint(meta.(*AWSClient).ec2conn, vpceId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading VPC Endpoint (%s): %w", vpceId, err)
+	}
+
+	if !vpcEndpointHasId(vpce.RouteTableIds, rtId) {
+		return nil, fmt.Errorf("VPC Endpoint (%s) is not associated with Route Table (%s)", vpceId, rtId)
+	}
+
 	d.SetId(vpcEndpointIdRouteTableIdHash(vpceId, rtId))
 	d.Set("vpc_endpoint_id", vpceId)
 	d.Set("route_table_id", rtId)
@@ -159,3 +161,14 @@ func findResourceVpcEndpoint(conn *ec2.EC2, id string) (*ec2.VpcEndpoint, error)
 func vpcEndpointIdRouteTableIdHash(endpointId, rtId string) string {
 	return fmt.Sprintf("a-%s%d", endpointId, hashcode.String(rtId))
 }
+
+// vpcEndpointHasId returns whether the specified identifier is one of the VPC endpoint's associated resource identifiers.
+func vpcEndpointHasId(ids []*string, id string) bool {
+	for _, v := range ids {
+		if aws.StringValue(v) == id {
+			return true
+		}
+	}
+
+	return false
+}
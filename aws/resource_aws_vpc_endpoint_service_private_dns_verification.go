@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func resourceAwsVpcEndpointServicePrivateDnsVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointServicePrivateDnsVerificationCreate,
+		Read:   resourceAwsVpcEndpointServicePrivateDnsVerificationRead,
+		Delete: resourceAwsVpcEndpointServicePrivateDnsVerificationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.VpcEndpointServicePrivateDnsNameVerifiedTimeout),
+		},
+	}
+}
+
+func resourceAwsVpcEndpointServicePrivateDnsVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	serviceID := d.Get("vpc_endpoint_service_id").(string)
+
+	input := &ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Starting VPC Endpoint Service private DNS verification: %s", input)
+	_, err := conn.StartVpcEndpointServicePrivateDnsVerification(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting VPC Endpoint Service (%s) private DNS verification: %w", serviceID, err)
+	}
+
+	d.SetId(serviceID)
+
+	if _, err := waiter.VpcEndpointServicePrivateDnsNameVerified(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for VPC Endpoint Service (%s) private DNS name to be verified: %w", d.Id(), err)
+	}
+
+	return resourceAwsVpcEndpointServicePrivateDnsVerificationRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServicePrivateDnsVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	serviceConfiguration, err := finder.VpcEndpointServiceConfigurationByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcEndpointServiceIdNotFound) {
+		log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing private DNS verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Endpoint Service (%s): %w", d.Id(), err)
+	}
+
+	if serviceConfiguration == nil {
+		log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing private DNS verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Changing the service's private DNS name requires it to be verified again.
+	if serviceConfiguration.PrivateDnsNameConfiguration == nil || aws.StringValue(serviceConfiguration.PrivateDnsNameConfiguration.State) != ec2.DnsNameStateVerified {
+		log.Printf("[WARN] VPC Endpoint Service (%s) private DNS name is not verified, removing private DNS verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_endpoint_service_id", serviceConfiguration.ServiceId)
+
+	return nil
+}
+
+func resourceAwsVpcEndpointServicePrivateDnsVerificationDelete(d *schema.ResourceData, meta interface{}) error {
+	// No need to do anything, the private DNS name remains verified until it is changed or the service is deleted
+	return nil
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSVpcEndpointServicePrivateDnsVerification_basic(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateDomainFromEnv(t)
+	domain := testAccAwsAcmCertificateRandomSubDomain(rootDomain)
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointServicePrivateDnsVerificationConfig(rName1, rName2, rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_service_id", serviceResourceName, "id"),
+				),
+			},
+			{
+				// Refresh the service to pick up the verified state.
+				Config: testAccVpcEndpointServicePrivateDnsVerificationConfig(rName1, rName2, rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceResourceName, "private_dns_name_configuration.0.state", "verified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcEndpointServicePrivateDnsVerificationConfig(rName1, rName2, rootDomain, domain string) string {
+	return composeConfig(
+		testAccVpcEndpointServiceConfigPrivateDnsName(rName1, rName2, domain),
+		fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = data.aws_route53_zone.test.zone_id
+  name    = "${aws_vpc_endpoint_service.test.private_dns_name_configuration[0].name}.${aws_vpc_endpoint_service.test.private_dns_name}"
+  type    = aws_vpc_endpoint_service.test.private_dns_name_configuration[0].type
+  records = [aws_vpc_endpoint_service.test.private_dns_name_configuration[0].value]
+  ttl     = 60
+}
+
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  vpc_endpoint_service_id = aws_vpc_endpoint_service.test.id
+
+  depends_on = [aws_route53_record.test]
+}
+`, rootDomain))
+}
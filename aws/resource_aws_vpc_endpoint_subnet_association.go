@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsVpcEndpointSubnetAssociation() *schema.Resource {
@@ -19,7 +23,7 @@ func resourceAwsVpcEndpointSubnetAssociation() *schema.Resource {
 		Read:   resourceAwsVpcEndpointSubnetAssociationRead,
 		Delete: resourceAwsVpcEndpointSubnetAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsVpcEndpointSubnetAssociationImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,33 +57,29 @@ func resourceAwsVpcEndpointSubnetAssociationCreate(d *schema.ResourceData, meta
 		return err
 	}
 
-	// See https://github.com/hashicorp/terraform-provider-aws/issues/3382.
-	// Prevent concurrent subnet association requests and delay between requests.
-	mk := "vpc_endpoint_subnet_association_" + endpointId
-	awsMutexKV.Lock(mk)
-	defer awsMutexKV.Unlock(mk)
-
-	c := &resource.StateChangeConf{
-		Delay:   1 * time.Minute,
-		Timeout: 3 * time.Minute,
-		Target:  []string{"ok"},
-		Refresh: func() (interface{}, string, error) {
-			res, err := conn.ModifyVpcEndpoint(&ec2.ModifyVpcEndpointInput{
-				VpcEndpointId: aws.String(endpointId),
-				AddSubnetIds:  aws.StringSlice([]string{snId}),
-			})
-			return res, "ok", err
-		},
-	}
-	_, err = c.WaitForState()
-	if err != nil {
+	if err := vpcEndpointModifySubnets(conn, endpointId, snId, true, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("Error creating Vpc Endpoint/Subnet association: %s", err)
 	}
 
 	d.SetId(vpcEndpointSubnetAssociationId(endpointId, snId))
 
-	if err := vpcEndpointWaitUntilAvailable(conn, endpointId, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return err
+	// The association may not be visible immediately.
+	_, err = tfresource.RetryWhenNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		vpce, err := findResourceVpcEndpoint(conn, endpointId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !vpcEndpointHasId(vpce.SubnetIds, snId) {
+			return nil, &resource.NotFoundError{
+				Message: fmt.Sprintf("Vpc Endpoint (%s) is not associated with Subnet (%s)", endpointId, snId),
+			}
+		}
+
+		return vpce, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for Vpc Endpoint/Subnet association (%s) to be visible: %w", d.Id(), err)
 	}
 
 	return resourceAwsVpcEndpointSubnetAssociationRead(d, meta)
@@ -102,14 +102,7 @@ func resourceAwsVpcEndpointSubnetAssociationRead(d *schema.ResourceData, meta in
 		return err
 	}
 
-	found := false
-	for _, id := range vpce.SubnetIds {
-		if aws.StringValue(id) == snId {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if !vpcEndpointHasId(vpce.SubnetIds, snId) {
 		log.Printf("[WARN] Vpc Endpoint/Subnet association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -124,10 +117,7 @@ func resourceAwsVpcEndpointSubnetAssociationDelete(d *schema.ResourceData, meta
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	snId := d.Get("subnet_id").(string)
 
-	_, err := conn.ModifyVpcEndpoint(&ec2.ModifyVpcEndpointInput{
-		VpcEndpointId:   aws.String(endpointId),
-		RemoveSubnetIds: aws.StringSlice([]string{snId}),
-	})
+	err := vpcEndpointModifySubnets(conn, endpointId, snId, false, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		ec2err, ok := err.(awserr.Error)
 		if !ok {
@@ -144,13 +134,133 @@ func resourceAwsVpcEndpointSubnetAssociationDelete(d *schema.ResourceData, meta
 		}
 	}
 
-	if err := vpcEndpointWaitUntilAvailable(conn, endpointId, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return err
+	return nil
+}
+
+func resourceAwsVpcEndpointSubnetAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'vpc-endpoint-id/subnet-id'", d.Id())
 	}
 
-	return nil
+	vpceId := parts[0]
+	snId := parts[1]
+	log.Printf("[DEBUG] Importing VPC Endpoint (%s) Subnet (%s) association", vpceId, snId)
+
+	vpce, err := findResourceVpcEndpoint(meta.(*AWSClient).ec2conn, vpceId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading VPC Endpoint (%s): %w", vpceId, err)
+	}
+
+	if !vpcEndpointHasId(vpce.SubnetIds, snId) {
+		return nil, fmt.Errorf("VPC Endpoint (%s) is not associated with Subnet (%s)", vpceId, snId)
+	}
+
+	d.SetId(vpcEndpointSubnetAssociationId(vpceId, snId))
+	d.Set("vpc_endpoint_id", vpceId)
+	d.Set("subnet_id", snId)
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func vpcEndpointSubnetAssociationId(endpointId, snId string) string {
 	return fmt.Sprintf("a-%s%d", endpointId, hashcode.String(snId))
 }
+
+// vpcEndpointSubnetAssociationBatchWindow is how long changes to the subnet associations of a VPC endpoint
+// are collected before they are made.
+const vpcEndpointSubnetAssociationBatchWindow = 5 * time.Second
+
+// vpcEndpointSubnetAssociationBatch is a set of subnets to associate with, or disassociate from,
+// a VPC endpoint in a single ModifyVpcEndpoint call.
+type vpcEndpointSubnetAssociationBatch struct {
+	subnetIds []string
+	errs      map[string]error
+	done      chan struct{}
+}
+
+var (
+	vpcEndpointSubnetAssociationBatchesMutex sync.Mutex
+	vpcEndpointSubnetAssociationBatches      = map[string]*vpcEndpointSubnetAssociationBatch{}
+)
+
+// vpcEndpointModifySubnets associates the subnet with, or disassociates it from, the VPC endpoint
+// and waits for the endpoint to become available again.
+// Every modification puts the endpoint back into the pending state, so concurrent changes for the
+// same endpoint are collected and made in a single ModifyVpcEndpoint call, followed by a single wait.
+// Only one such call is made at a time for each endpoint.
+// See https://github.com/hashicorp/terraform-provider-aws/issues/3382.
+func vpcEndpointModifySubnets(conn *ec2.EC2, endpointId, snId string, add bool, timeout time.Duration) error {
+	key := fmt.Sprintf("%s/%t", endpointId, add)
+
+	vpcEndpointSubnetAssociationBatchesMutex.Lock()
+	batch, ok := vpcEndpointSubnetAssociationBatches[key]
+	if !ok {
+		batch = &vpcEndpointSubnetAssociationBatch{
+			done: make(chan struct{}),
+		}
+		vpcEndpointSubnetAssociationBatches[key] = batch
+
+		go batch.flush(conn, key, endpointId, add, timeout)
+	}
+	batch.subnetIds = append(batch.subnetIds, snId)
+	vpcEndpointSubnetAssociationBatchesMutex.Unlock()
+
+	<-batch.done
+
+	return batch.errs[snId]
+}
+
+func (b *vpcEndpointSubnetAssociationBatch) flush(conn *ec2.EC2, key, endpointId string, add bool, timeout time.Duration) {
+	defer close(b.done)
+
+	time.Sleep(vpcEndpointSubnetAssociationBatchWindow)
+
+	mk := "vpc_endpoint_subnet_association_" + endpointId
+	awsMutexKV.Lock(mk)
+	defer awsMutexKV.Unlock(mk)
+
+	// Changes requested from now on are made in the next batch.
+	vpcEndpointSubnetAssociationBatchesMutex.Lock()
+	delete(vpcEndpointSubnetAssociationBatches, key)
+	snIds := b.subnetIds
+	vpcEndpointSubnetAssociationBatchesMutex.Unlock()
+
+	b.errs = make(map[string]error, len(snIds))
+
+	err := vpcEndpointModifySubnetsAndWait(conn, endpointId, snIds, add, timeout)
+
+	if err != nil && len(snIds) > 1 {
+		// Make the changes one at a time so that only the offending associations fail.
+		log.Printf("[WARN] Error modifying Vpc Endpoint (%s) subnets %s, retrying one at a time: %s", endpointId, snIds, err)
+
+		for _, snId := range snIds {
+			b.errs[snId] = vpcEndpointModifySubnetsAndWait(conn, endpointId, []string{snId}, add, timeout)
+		}
+
+		return
+	}
+
+	for _, snId := range snIds {
+		b.errs[snId] = err
+	}
+}
+
+func vpcEndpointModifySubnetsAndWait(conn *ec2.EC2, endpointId string, snIds []string, add bool, timeout time.Duration) error {
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(endpointId),
+	}
+
+	if add {
+		input.AddSubnetIds = aws.StringSlice(snIds)
+	} else {
+		input.RemoveSubnetIds = aws.StringSlice(snIds)
+	}
+
+	log.Printf("[DEBUG] Modifying Vpc Endpoint subnets: %s", input)
+	if _, err := conn.ModifyVpcEndpoint(input); err != nil {
+		return err
+	}
+
+	return vpcEndpointWaitUntilAvailable(conn, endpointId, timeout)
+}
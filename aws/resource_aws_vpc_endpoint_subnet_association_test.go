@@ -26,6 +26,12 @@ func TestAccAWSVpcEndpointSubnetAssociation_basic(t *testing.T) {
 						"aws_vpc_endpoint_subnet_association.a", &vpce),
 				),
 			},
+			{
+				ResourceName:      "aws_vpc_endpoint_subnet_association.a",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSVpcEndpointSubnetAssociationImportStateIdFunc("aws_vpc_endpoint_subnet_association.a"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -53,6 +59,18 @@ func TestAccAWSVpcEndpointSubnetAssociation_multiple(t *testing.T) {
 	})
 }
 
+func testAccAWSVpcEndpointSubnetAssociationImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		id := fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpc_endpoint_id"], rs.Primary.Attributes["subnet_id"])
+		return id, nil
+	}
+}
+
 func testAccCheckVpcEndpointSubnetAssociationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
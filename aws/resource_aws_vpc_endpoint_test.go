@@ -307,6 +307,11 @@ func TestAccAWSVpcEndpoint_interfaceWithSubnetAndSecurityGroup(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_configuration.0.subnet_id", "aws_subnet.test1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_configuration.0.availability_zone", "aws_subnet.test1", "availability_zone"),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_configuration.0.ipv4"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_configuration.0.ipv6", ""),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "requester_managed", "false"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
@@ -537,6 +542,28 @@ func TestAccAWSVpcEndpoint_VpcEndpointType_GatewayLoadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpoint_VpcEndpointType_GatewayLoadBalancer_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVpcEndpointConfigVpcEndpointTypeGatewayLoadBalancerInvalid(`subnet_ids = ["subnet-11111111", "subnet-22222222"]`),
+				ExpectError: regexp.MustCompile(`must have exactly one subnet`),
+			},
+			{
+				Config:      testAccVpcEndpointConfigVpcEndpointTypeGatewayLoadBalancerInvalid(`security_group_ids = ["sg-11111111"]`),
+				ExpectError: regexp.MustCompile(`security_group_ids cannot be specified`),
+			},
+			{
+				Config:      testAccVpcEndpointConfigVpcEndpointTypeGatewayLoadBalancerInvalid(`private_dns_enabled = true`),
+				ExpectError: regexp.MustCompile(`private_dns_enabled cannot be enabled`),
+			},
+		},
+	})
+}
+
 func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -1154,6 +1181,18 @@ resource "aws_vpc_endpoint" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
+func testAccVpcEndpointConfigVpcEndpointTypeGatewayLoadBalancerInvalid(argument string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_endpoint" "test" {
+  service_name      = "com.amazonaws.vpce.us-west-2.vpce-svc-11111111111111111"
+  vpc_endpoint_type = "GatewayLoadBalancer"
+  vpc_id            = "vpc-11111111"
+
+  %[1]s
+}
+`, argument)
+}
+
 func testAccVpcEndpointConfigVpcEndpointTypeGatewayLoadBalancer(rName string) string {
 	return composeConfig(
 		testAccAvailableAZsNoOptInConfig(),
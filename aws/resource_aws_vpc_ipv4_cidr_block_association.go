@@ -163,7 +163,14 @@ func vpcIpv4CidrBlockAssociationStateRefresh(conn *ec2.EC2, vpcId, assocId strin
 		if vpc != nil {
 			for _, cidrAssociation := range vpc.CidrBlockAssociationSet {
 				if aws.StringValue(cidrAssociation.AssociationId) == assocId {
-					return cidrAssociation, aws.StringValue(cidrAssociation.CidrBlockState.State), nil
+					state := aws.StringValue(cidrAssociation.CidrBlockState.State)
+
+					switch state {
+					case ec2.VpcCidrBlockStateCodeFailing, ec2.VpcCidrBlockStateCodeFailed:
+						return cidrAssociation, state, fmt.Errorf("VPC IPv4 CIDR block association (%s) %s: %s", assocId, state, aws.StringValue(cidrAssociation.CidrBlockState.StatusMessage))
+					}
+
+					return cidrAssociation, state, nil
 				}
 			}
 		}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+)
+
+func resourceAwsVpcIpv6CidrBlockAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpv6CidrBlockAssociationCreate,
+		Read:   resourceAwsVpcIpv6CidrBlockAssociationRead,
+		Delete: resourceAwsVpcIpv6CidrBlockAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ipv6_cidr_block": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ipv6_cidr_block_network_border_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsVpcIpv6CidrBlockAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.AssociateVpcCidrBlockInput{
+		VpcId:                       aws.String(d.Get("vpc_id").(string)),
+		AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("ipv6_cidr_block_network_border_group"); ok {
+		req.Ipv6CidrBlockNetworkBorderGroup = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating VPC IPv6 CIDR block association: %s", req)
+	resp, err := conn.AssociateVpcCidrBlock(req)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC IPv6 CIDR block association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Ipv6CidrBlockAssociation.AssociationId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeAssociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeAssociated},
+		Refresh:    vpcIpv6CidrBlockAssociationStateRefresh(conn, d.Get("vpc_id").(string), d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for IPv6 CIDR block association (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpv6CidrBlockAssociationRead(d, meta)
+}
+
+func resourceAwsVpcIpv6CidrBlockAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeVpcsInput{
+		Filters: buildEC2AttributeFilterList(
+			map[string]string{
+				"ipv6-cidr-block-association.association-id": d.Id(),
+			},
+		),
+	}
+
+	log.Printf("[DEBUG] Describing VPCs: %s", input)
+	output, err := conn.DescribeVpcs(input)
+	if err != nil {
+		return fmt.Errorf("error describing VPCs: %s", err)
+	}
+
+	if output == nil || len(output.Vpcs) == 0 || output.Vpcs[0] == nil {
+		log.Printf("[WARN] IPv6 CIDR block association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	vpc := output.Vpcs[0]
+
+	var vpcIpv6CidrBlockAssociation *ec2.VpcIpv6CidrBlockAssociation
+	for _, ipv6CidrBlockAssociation := range vpc.Ipv6CidrBlockAssociationSet {
+		if aws.StringValue(ipv6CidrBlockAssociation.AssociationId) == d.Id() {
+			vpcIpv6CidrBlockAssociation = ipv6CidrBlockAssociation
+			break
+		}
+	}
+
+	if vpcIpv6CidrBlockAssociation == nil || vpcIpv6CidrBlockAssociation.Ipv6CidrBlockState == nil {
+		log.Printf("[WARN] IPv6 CIDR block association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch state := aws.StringValue(vpcIpv6CidrBlockAssociation.Ipv6CidrBlockState.State); state {
+	case ec2.VpcCidrBlockStateCodeDisassociating, ec2.VpcCidrBlockStateCodeDisassociated, ec2.VpcCidrBlockStateCodeFailed:
+		log.Printf("[WARN] IPv6 CIDR block association (%s) in state %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ipv6_cidr_block", vpcIpv6CidrBlockAssociation.Ipv6CidrBlock)
+	d.Set("ipv6_cidr_block_network_border_group", vpcIpv6CidrBlockAssociation.NetworkBorderGroup)
+	d.Set("vpc_id", vpc.VpcId)
+
+	return nil
+}
+
+func resourceAwsVpcIpv6CidrBlockAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting VPC IPv6 CIDR block association: %s", d.Id())
+	_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
+		AssociationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcIDNotFound) || tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcCidrBlockAssociationIDNotFound) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC IPv6 CIDR block association: %s", err)
+	}
+
+	// Subnets and routes using the CIDR block must be gone before the block is released.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeAssociated, ec2.VpcCidrBlockStateCodeDisassociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeDisassociated, VpcCidrBlockStateCodeDeleted},
+		Refresh:    vpcIpv6CidrBlockAssociationStateRefresh(conn, d.Get("vpc_id").(string), d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for VPC IPv6 CIDR block association (%s) to be deleted: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func vpcIpv6CidrBlockAssociationStateRefresh(conn *ec2.EC2, vpcId, assocId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vpc, err := vpcDescribe(conn, vpcId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if vpc != nil {
+			for _, ipv6CidrAssociation := range vpc.Ipv6CidrBlockAssociationSet {
+				if ipv6CidrAssociation == nil || aws.StringValue(ipv6CidrAssociation.AssociationId) != assocId {
+					continue
+				}
+
+				// The state may not be reported yet; keep polling.
+				if ipv6CidrAssociation.Ipv6CidrBlockState == nil {
+					return nil, "", nil
+				}
+
+				return ipv6CidrAssociation, aws.StringValue(ipv6CidrAssociation.Ipv6CidrBlockState.State), nil
+			}
+		}
+
+		return "", VpcCidrBlockStateCodeDeleted, nil
+	}
+}
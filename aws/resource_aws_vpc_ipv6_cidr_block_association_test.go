@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAwsVpcIpv6CidrBlockAssociation_basic(t *testing.T) {
+	var association ec2.VpcIpv6CidrBlockAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_ipv6_cidr_block_association.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsVpcIpv6CidrBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpcIpv6CidrBlockAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsVpcIpv6CidrBlockAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", vpcResourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "ipv6_cidr_block", regexp.MustCompile(`/56$`)),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_cidr_block_network_border_group", testAccGetRegion()),
+					// The VPC keeps reporting its own IPv6 CIDR block.
+					resource.TestCheckResourceAttr(vpcResourceName, "assign_generated_ipv6_cidr_block", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsVpcIpv6CidrBlockAssociation_VpcWithoutIpv6(t *testing.T) {
+	var association ec2.VpcIpv6CidrBlockAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_ipv6_cidr_block_association.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsVpcIpv6CidrBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpcIpv6CidrBlockAssociationConfigVpcWithoutIpv6(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsVpcIpv6CidrBlockAssociationExists(resourceName, &association),
+					// The VPC must not adopt the association resource's IPv6 CIDR block.
+					resource.TestCheckResourceAttr(vpcResourceName, "assign_generated_ipv6_cidr_block", "false"),
+					resource.TestCheckResourceAttr(vpcResourceName, "ipv6_association_id", ""),
+					resource.TestCheckResourceAttr(vpcResourceName, "ipv6_cidr_block", ""),
+				),
+			},
+			{
+				Config:   testAccAwsVpcIpv6CidrBlockAssociationConfigVpcWithoutIpv6(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsVpcIpv6CidrBlockAssociation_disappears(t *testing.T) {
+	var association ec2.VpcIpv6CidrBlockAssociation
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_ipv6_cidr_block_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsVpcIpv6CidrBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpcIpv6CidrBlockAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsVpcIpv6CidrBlockAssociationExists(resourceName, &association),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsVpcIpv6CidrBlockAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsVpcIpv6CidrBlockAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipv6_cidr_block_association" {
+			continue
+		}
+
+		vpc, err := vpcDescribe(conn, rs.Primary.Attributes["vpc_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if vpc == nil {
+			continue
+		}
+
+		for _, ipv6Association := range vpc.Ipv6CidrBlockAssociationSet {
+			if aws.StringValue(ipv6Association.AssociationId) != rs.Primary.ID {
+				continue
+			}
+
+			if state := aws.StringValue(ipv6Association.Ipv6CidrBlockState.State); state != ec2.VpcCidrBlockStateCodeDisassociated {
+				return fmt.Errorf("VPC IPv6 CIDR block association (%s) still exists in state %s", rs.Primary.ID, state)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsVpcIpv6CidrBlockAssociationExists(n string, association *ec2.VpcIpv6CidrBlockAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC IPv6 CIDR block association ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		vpc, err := vpcDescribe(conn, rs.Primary.Attributes["vpc_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if vpc == nil {
+			return fmt.Errorf("VPC (%s) not found", rs.Primary.Attributes["vpc_id"])
+		}
+
+		for _, ipv6Association := range vpc.Ipv6CidrBlockAssociationSet {
+			if aws.StringValue(ipv6Association.AssociationId) == rs.Primary.ID {
+				*association = *ipv6Association
+				return nil
+			}
+		}
+
+		return fmt.Errorf("VPC IPv6 CIDR block association (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccAwsVpcIpv6CidrBlockAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipv6_cidr_block_association" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
+
+func testAccAwsVpcIpv6CidrBlockAssociationConfigVpcWithoutIpv6(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipv6_cidr_block_association" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
@@ -61,9 +61,11 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
-			"accepter":  vpcPeeringConnectionOptionsSchema(),
-			"requester": vpcPeeringConnectionOptionsSchema(),
-			"tags":      tagsSchema(),
+			"accepter":           vpcPeeringConnectionOptionsSchema(),
+			"accepter_vpc_info":  vpcPeeringConnectionVpcInfoSchema(),
+			"requester":          vpcPeeringConnectionOptionsSchema(),
+			"requester_vpc_info": vpcPeeringConnectionVpcInfoSchema(),
+			"tags":               tagsSchema(),
 		},
 	}
 }
@@ -165,6 +167,13 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting VPC Peering Connection requester information: %s", err)
 	}
 
+	if err := d.Set("accepter_vpc_info", flattenVpcPeeringConnectionVpcInfo(pc.AccepterVpcInfo)); err != nil {
+		return fmt.Errorf("error setting accepter_vpc_info: %w", err)
+	}
+	if err := d.Set("requester_vpc_info", flattenVpcPeeringConnectionVpcInfo(pc.RequesterVpcInfo)); err != nil {
+		return fmt.Errorf("error setting requester_vpc_info: %w", err)
+	}
+
 	err = d.Set("tags", keyvaluetags.Ec2KeyValueTags(pc.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 	if err != nil {
 		return fmt.Errorf("Error setting VPC Peering Connection tags: %s", err)
@@ -217,9 +226,19 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		log.Printf("[DEBUG] VPC Peering Connection accept status: %s", statusCode)
 
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
 		// "OperationNotPermitted: Peering pcx-0000000000000000 is not active. Peering options can be added only to active peerings."
-		if err := vpcPeeringConnectionWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("Error waiting for VPC Peering Connection to become available: %s", err)
+		if err := vpcPeeringConnectionWaitUntilActive(conn, d.Id(), timeout); err != nil {
+			return err
+		}
+
+		pcRaw, statusCode, err = vpcPeeringConnectionRefreshState(conn, d.Id())()
+		if err != nil {
+			return fmt.Errorf("Error reading VPC Peering Connection: %s", err)
 		}
 	}
 
@@ -381,6 +400,62 @@ func vpcPeeringConnectionWaitUntilAvailable(conn *ec2.EC2, id string, timeout ti
 	return nil
 }
 
+// vpcPeeringConnectionWaitUntilActive waits for an accepted VPC peering connection to become active.
+// The acceptance of a cross-region peering connection can take a while to be reflected in the requester's
+// region, so pending-acceptance is a pending state here.
+func vpcPeeringConnectionWaitUntilActive(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become active.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+			ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+		},
+		Target: []string{
+			ec2.VpcPeeringConnectionStateReasonCodeActive,
+		},
+		Refresh: vpcPeeringConnectionRefreshState(conn, id),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Peering Connection (%s) to become active: %s", id, err)
+	}
+	return nil
+}
+
+func vpcPeeringConnectionVpcInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr_blocks": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"ipv6_cidr_blocks": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"owner_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vpc_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func waitForEc2VpcPeeringConnectionDeletion(conn *ec2.EC2, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -53,9 +53,11 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"accepter":  vpcPeeringConnectionOptionsSchema(),
-			"requester": vpcPeeringConnectionOptionsSchema(),
-			"tags":      tagsSchema(),
+			"accepter":           vpcPeeringConnectionOptionsSchema(),
+			"accepter_vpc_info":  vpcPeeringConnectionVpcInfoSchema(),
+			"requester":          vpcPeeringConnectionOptionsSchema(),
+			"requester_vpc_info": vpcPeeringConnectionVpcInfoSchema(),
+			"tags":               tagsSchema(),
 		},
 	}
 }
@@ -144,6 +144,50 @@ func TestAccAWSVPCPeeringConnectionAccepter_sameRegionDifferentAccount(t *testin
 	})
 }
 
+func TestAccAWSVPCPeeringConnectionAccepter_tagsDifferentAccount(t *testing.T) {
+	var connection ec2.VpcPeeringConnection
+	var providers []*schema.Provider
+	resourceNameConnection := "aws_vpc_peering_connection.main"        // Requester
+	resourceNameAccepter := "aws_vpc_peering_connection_accepter.peer" // Accepter
+	rName := fmt.Sprintf("terraform-testacc-pcxaccpt-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccAwsVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVPCPeeringConnectionAccepterConfigTagsDifferentAccount(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionExists(resourceNameConnection, &connection),
+					// Each account sees only its own tags on the peering connection.
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.Side", "requester"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.Side", "accepter"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsVPCPeeringConnectionAccepterConfigTagsDifferentAccount(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionExists(resourceNameConnection, &connection),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.Side", "requester"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.Side", "accepter"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVPCPeeringConnectionAccepter_differentRegionDifferentAccount(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
 	var providers []*schema.Provider
@@ -374,3 +418,56 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
 }
 `, rName, testAccGetAlternateRegion())
 }
+
+func testAccAwsVPCPeeringConnectionAccepterConfigTagsDifferentAccount(rName, tagKey1, tagValue1 string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+# Requester's side of the connection.
+resource "aws_vpc_peering_connection" "main" {
+  vpc_id        = aws_vpc.main.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.peer.account_id
+  auto_accept   = false
+
+  tags = {
+    Name = %[1]q
+    Side = "requester"
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+  provider = "awsalternate"
+
+  vpc_peering_connection_id = aws_vpc_peering_connection.main.id
+  auto_accept               = true
+
+  tags = {
+    Name = %[1]q
+    Side = "accepter"
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
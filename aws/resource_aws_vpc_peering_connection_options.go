@@ -7,8 +7,15 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	vpcPeeringConnectionOptionsModifyTimeout = 3 * time.Minute
 )
 
 func resourceAwsVpcPeeringConnectionOptions() *schema.Resource {
@@ -56,11 +63,19 @@ func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta int
 
 	d.Set("vpc_peering_connection_id", pc.VpcPeeringConnectionId)
 
-	if err := d.Set("accepter", flattenVpcPeeringConnectionOptions(pc.AccepterVpcInfo.PeeringOptions)); err != nil {
-		return fmt.Errorf("error setting VPC Peering Connection Options accepter information: %s", err)
+	// In a cross-account VPC peering connection only the options of the caller's side
+	// are authoritative, so leave the other side's options as they are in state.
+	accepterOwned, requesterOwned := vpcPeeringConnectionOptionsOwnedSides(meta.(*AWSClient).accountid, pc)
+
+	if accepterOwned {
+		if err := d.Set("accepter", flattenVpcPeeringConnectionOptions(pc.AccepterVpcInfo.PeeringOptions)); err != nil {
+			return fmt.Errorf("error setting VPC Peering Connection Options accepter information: %s", err)
+		}
 	}
-	if err := d.Set("requester", flattenVpcPeeringConnectionOptions(pc.RequesterVpcInfo.PeeringOptions)); err != nil {
-		return fmt.Errorf("error setting VPC Peering Connection Options requester information: %s", err)
+	if requesterOwned {
+		if err := d.Set("requester", flattenVpcPeeringConnectionOptions(pc.RequesterVpcInfo.PeeringOptions)); err != nil {
+			return fmt.Errorf("error setting VPC Peering Connection Options requester information: %s", err)
+		}
 	}
 
 	return nil
@@ -79,21 +94,42 @@ func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta i
 		return fmt.Errorf("VPC Peering Connection (%s) not found", d.Id())
 	}
 
-	if d.HasChanges("accepter", "requester") {
-		crossRegionPeering := aws.StringValue(pc.RequesterVpcInfo.Region) != aws.StringValue(pc.AccepterVpcInfo.Region)
+	crossRegionPeering := aws.StringValue(pc.RequesterVpcInfo.Region) != aws.StringValue(pc.AccepterVpcInfo.Region)
+	accepterOwned, requesterOwned := vpcPeeringConnectionOptionsOwnedSides(meta.(*AWSClient).accountid, pc)
 
-		input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
-			VpcPeeringConnectionId: aws.String(d.Id()),
-		}
-		if d.HasChange("accepter") {
-			input.AccepterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(d.Get("accepter").([]interface{}), crossRegionPeering)
+	input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+		VpcPeeringConnectionId: aws.String(d.Id()),
+	}
+
+	// Compare the configured options with the current options rather than with state
+	// so that changes made outside of Terraform are converged.
+	if options := expandVpcPeeringConnectionOptions(d.Get("accepter").([]interface{}), crossRegionPeering); vpcPeeringConnectionOptionsDiffer(options, pc.AccepterVpcInfo.PeeringOptions) {
+		if !accepterOwned {
+			return fmt.Errorf("error modifying VPC Peering Connection (%s) Options: accepter options can only be modified by the accepter account (%s)", d.Id(), aws.StringValue(pc.AccepterVpcInfo.OwnerId))
 		}
-		if d.HasChange("requester") {
-			input.RequesterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(d.Get("requester").([]interface{}), crossRegionPeering)
+
+		input.AccepterPeeringConnectionOptions = options
+	}
+	if options := expandVpcPeeringConnectionOptions(d.Get("requester").([]interface{}), crossRegionPeering); vpcPeeringConnectionOptionsDiffer(options, pc.RequesterVpcInfo.PeeringOptions) {
+		if !requesterOwned {
+			return fmt.Errorf("error modifying VPC Peering Connection (%s) Options: requester options can only be modified by the requester account (%s)", d.Id(), aws.StringValue(pc.RequesterVpcInfo.OwnerId))
 		}
 
+		input.RequesterPeeringConnectionOptions = options
+	}
+
+	if input.AccepterPeeringConnectionOptions != nil || input.RequesterPeeringConnectionOptions != nil {
 		log.Printf("[DEBUG] Modifying VPC Peering Connection options: %s", input)
-		_, err = conn.ModifyVpcPeeringConnectionOptions(input)
+		_, err = tfresource.RetryWhen(
+			vpcPeeringConnectionOptionsModifyTimeout,
+			func() (interface{}, error) {
+				return conn.ModifyVpcPeeringConnectionOptions(input)
+			},
+			func(err error) bool {
+				// "OperationNotPermitted: Peering pcx-0000000000000000 is not active. Peering options can be added only to active peerings."
+				return tfawserr.ErrMessageContains(err, tfec2.ErrCodeOperationNotPermitted, "is not active")
+			},
+		)
 
 		if err != nil {
 			return fmt.Errorf("error modifying VPC Peering Connection (%s) Options: %w", d.Id(), err)
@@ -101,7 +137,7 @@ func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta i
 
 		// Retry reading back the modified options to deal with eventual consistency.
 		// Often this is to do with a delay transitioning from pending-acceptance to active.
-		err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err = resource.Retry(vpcPeeringConnectionOptionsModifyTimeout, func() *resource.RetryError {
 			pc, err = vpcPeeringConnection(conn, d.Id())
 
 			if err != nil {
@@ -112,23 +148,19 @@ func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta i
 				return nil
 			}
 
-			if d.HasChange("accepter") && pc.AccepterVpcInfo != nil {
-				if aws.BoolValue(pc.AccepterVpcInfo.PeeringOptions.AllowDnsResolutionFromRemoteVpc) != aws.BoolValue(input.AccepterPeeringConnectionOptions.AllowDnsResolutionFromRemoteVpc) ||
-					aws.BoolValue(pc.AccepterVpcInfo.PeeringOptions.AllowEgressFromLocalClassicLinkToRemoteVpc) != aws.BoolValue(input.AccepterPeeringConnectionOptions.AllowEgressFromLocalClassicLinkToRemoteVpc) ||
-					aws.BoolValue(pc.AccepterVpcInfo.PeeringOptions.AllowEgressFromLocalVpcToRemoteClassicLink) != aws.BoolValue(input.AccepterPeeringConnectionOptions.AllowEgressFromLocalVpcToRemoteClassicLink) {
-					return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) accepter Options not stable", d.Id()))
-				}
+			if pc.AccepterVpcInfo != nil && vpcPeeringConnectionOptionsDiffer(input.AccepterPeeringConnectionOptions, pc.AccepterVpcInfo.PeeringOptions) {
+				return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) accepter Options not stable", d.Id()))
 			}
-			if d.HasChange("requester") && pc.RequesterVpcInfo != nil {
-				if aws.BoolValue(pc.RequesterVpcInfo.PeeringOptions.AllowDnsResolutionFromRemoteVpc) != aws.BoolValue(input.RequesterPeeringConnectionOptions.AllowDnsResolutionFromRemoteVpc) ||
-					aws.BoolValue(pc.RequesterVpcInfo.PeeringOptions.AllowEgressFromLocalClassicLinkToRemoteVpc) != aws.BoolValue(input.RequesterPeeringConnectionOptions.AllowEgressFromLocalClassicLinkToRemoteVpc) ||
-					aws.BoolValue(pc.RequesterVpcInfo.PeeringOptions.AllowEgressFromLocalVpcToRemoteClassicLink) != aws.BoolValue(input.RequesterPeeringConnectionOptions.AllowEgressFromLocalVpcToRemoteClassicLink) {
-					return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) requester Options not stable", d.Id()))
-				}
+			if pc.RequesterVpcInfo != nil && vpcPeeringConnectionOptionsDiffer(input.RequesterPeeringConnectionOptions, pc.RequesterVpcInfo.PeeringOptions) {
+				return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) requester Options not stable", d.Id()))
 			}
 
 			return nil
 		})
+
+		if err != nil {
+			return fmt.Errorf("error waiting for VPC Peering Connection (%s) Options to stabilize: %w", d.Id(), err)
+		}
 	}
 
 	return resourceAwsVpcPeeringConnectionOptionsRead(d, meta)
@@ -138,3 +170,37 @@ func resourceAwsVpcPeeringConnectionOptionsDelete(d *schema.ResourceData, meta i
 	// Don't do anything with the underlying VPC peering connection.
 	return nil
 }
+
+// vpcPeeringConnectionOptionsOwnedSides returns whether the specified account owns
+// the accepter and requester VPCs of the VPC peering connection.
+// Both sides are considered owned if the account ID is unknown.
+func vpcPeeringConnectionOptionsOwnedSides(accountID string, pc *ec2.VpcPeeringConnection) (bool, bool) {
+	if accountID == "" {
+		return true, true
+	}
+
+	return aws.StringValue(pc.AccepterVpcInfo.OwnerId) == accountID, aws.StringValue(pc.RequesterVpcInfo.OwnerId) == accountID
+}
+
+// vpcPeeringConnectionOptionsDiffer returns whether any of the requested options differ from the current options.
+func vpcPeeringConnectionOptionsDiffer(request *ec2.PeeringConnectionOptionsRequest, options *ec2.VpcPeeringConnectionOptionsDescription) bool {
+	if request == nil {
+		return false
+	}
+
+	if options == nil {
+		return true
+	}
+
+	if request.AllowDnsResolutionFromRemoteVpc != nil && aws.BoolValue(request.AllowDnsResolutionFromRemoteVpc) != aws.BoolValue(options.AllowDnsResolutionFromRemoteVpc) {
+		return true
+	}
+	if request.AllowEgressFromLocalClassicLinkToRemoteVpc != nil && aws.BoolValue(request.AllowEgressFromLocalClassicLinkToRemoteVpc) != aws.BoolValue(options.AllowEgressFromLocalClassicLinkToRemoteVpc) {
+		return true
+	}
+	if request.AllowEgressFromLocalVpcToRemoteClassicLink != nil && aws.BoolValue(request.AllowEgressFromLocalVpcToRemoteClassicLink) != aws.BoolValue(options.AllowEgressFromLocalVpcToRemoteClassicLink) {
+		return true
+	}
+
+	return false
+}
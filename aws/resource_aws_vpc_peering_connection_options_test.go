@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccAWSVpcPeeringConnectionOptions_basic(t *testing.T) {
@@ -98,6 +99,45 @@ func TestAccAWSVpcPeeringConnectionOptions_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcPeeringConnectionOptions_accepterDrift(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_vpc_peering_connection_options.test"
+	pcxResourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcPeeringConnectionOptionsConfig_sameRegion_sameAccount(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "accepter.0.allow_remote_vpc_dns_resolution", "true"),
+					testAccCheckAWSVpcPeeringConnectionOptionsModify(pcxResourceName, "accepter", &ec2.PeeringConnectionOptionsRequest{
+						AllowDnsResolutionFromRemoteVpc: aws.Bool(false),
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVpcPeeringConnectionOptionsConfig_sameRegion_sameAccount(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "accepter.0.allow_remote_vpc_dns_resolution", "true"),
+					testAccCheckAWSVpcPeeringConnectionOptions(
+						pcxResourceName,
+						"accepter",
+						&ec2.VpcPeeringConnectionOptionsDescription{
+							AllowDnsResolutionFromRemoteVpc:            aws.Bool(true),
+							AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+							AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcPeeringConnectionOptions_differentRegionSameAccount(t *testing.T) {
 	var providers []*schema.Provider
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -243,6 +283,31 @@ func TestAccAWSVpcPeeringConnectionOptions_sameRegionDifferentAccount(t *testing
 	})
 }
 
+// testAccCheckAWSVpcPeeringConnectionOptionsModify modifies one side's options outside of Terraform.
+func testAccCheckAWSVpcPeeringConnectionOptionsModify(n, block string, options *ec2.PeeringConnectionOptionsRequest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId: aws.String(rs.Primary.ID),
+		}
+		if block == "requester" {
+			input.RequesterPeeringConnectionOptions = options
+		} else {
+			input.AccepterPeeringConnectionOptions = options
+		}
+
+		_, err := conn.ModifyVpcPeeringConnectionOptions(input)
+
+		return err
+	}
+}
+
 func testAccVpcPeeringConnectionOptionsConfig_sameRegion_sameAccount(rName string, accepterDnsResolution, requesterRemoteClassicLink bool) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -105,6 +105,16 @@ func TestAccAWSVPCPeeringConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSVpcPeeringConnectionExists(resourceName, &connection),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_vpc_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_vpc_info.0.cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_vpc_info.0.cidr_blocks.0", "10.1.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "accepter_vpc_info.0.vpc_id", "aws_vpc.peer", "id"),
+					resource.TestCheckResourceAttr(resourceName, "requester_vpc_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester_vpc_info.0.cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester_vpc_info.0.cidr_blocks.0", "10.0.0.0/16"),
+					testAccCheckResourceAttrAccountID(resourceName, "requester_vpc_info.0.owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "requester_vpc_info.0.region", testAccGetRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "requester_vpc_info.0.vpc_id", "aws_vpc.test", "id"),
 				),
 			},
 			{
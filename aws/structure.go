@@ -4374,6 +4374,43 @@ func flattenVpcPeeringConnectionOptions(options *ec2.VpcPeeringConnectionOptions
 	}}
 }
 
+func flattenVpcPeeringConnectionVpcInfo(info *ec2.VpcPeeringConnectionVpcInfo) []interface{} {
+	if info == nil {
+		return []interface{}{}
+	}
+
+	cidrBlocks := make([]string, 0, len(info.CidrBlockSet))
+	for _, v := range info.CidrBlockSet {
+		if v == nil {
+			continue
+		}
+
+		cidrBlocks = append(cidrBlocks, aws.StringValue(v.CidrBlock))
+	}
+
+	// The primary CIDR block is not listed while the connection is pending acceptance.
+	if len(cidrBlocks) == 0 && aws.StringValue(info.CidrBlock) != "" {
+		cidrBlocks = append(cidrBlocks, aws.StringValue(info.CidrBlock))
+	}
+
+	ipv6CidrBlocks := make([]string, 0, len(info.Ipv6CidrBlockSet))
+	for _, v := range info.Ipv6CidrBlockSet {
+		if v == nil {
+			continue
+		}
+
+		ipv6CidrBlocks = append(ipv6CidrBlocks, aws.StringValue(v.Ipv6CidrBlock))
+	}
+
+	return []interface{}{map[string]interface{}{
+		"cidr_blocks":      cidrBlocks,
+		"ipv6_cidr_blocks": ipv6CidrBlocks,
+		"owner_id":         aws.StringValue(info.OwnerId),
+		"region":           aws.StringValue(info.Region),
+		"vpc_id":           aws.StringValue(info.VpcId),
+	}}
+}
+
 func expandVpcPeeringConnectionOptions(vOptions []interface{}, crossRegionPeering bool) *ec2.PeeringConnectionOptionsRequest {
 	if len(vOptions) == 0 || vOptions[0] == nil {
 		return nil
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
 const (
@@ -728,6 +729,17 @@ func validateEC2AutomateARN(v interface{}, k string) (ws []string, errors []erro
 	return
 }
 
+// validateEc2TransitGatewayIDOrARN validates a transit gateway identifier or transit gateway ARN.
+func validateEc2TransitGatewayIDOrARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := tfec2.TransitGatewayIDFromARNOrID(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid transit gateway ID or ARN: %w", k, value, err))
+	}
+
+	return ws, errors
+}
+
 func validatePolicyStatementId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 